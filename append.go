@@ -0,0 +1,108 @@
+package slices
+
+import "github.com/thamaji/slices/tuple"
+
+// 値を変換してdstに追加したスライスを返す。
+func AppendMap[T1, T2 any](dst []T2, slice []T1, f func(T1) T2) []T2 {
+	for i := range slice {
+		dst = append(dst, f(slice[i]))
+	}
+	return dst
+}
+
+// 値の一致する要素だけをdstに追加したスライスを返す。
+func AppendFilter[T comparable](dst []T, slice []T, v T) []T {
+	for i := range slice {
+		if slice[i] == v {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 条件を満たす要素だけをdstに追加したスライスを返す。
+func AppendFilterBy[T any](dst []T, slice []T, f func(T) bool) []T {
+	for i := range slice {
+		if f(slice[i]) {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 値の一致しない要素だけをdstに追加したスライスを返す。
+func AppendFilterNot[T comparable](dst []T, slice []T, v T) []T {
+	for i := range slice {
+		if slice[i] != v {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 条件を満たさない要素だけをdstに追加したスライスを返す。
+func AppendFilterNotBy[T any](dst []T, slice []T, f func(T) bool) []T {
+	for i := range slice {
+		if !f(slice[i]) {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 値をスライスに変換し、それらをdstに追加したスライスを返す。
+func AppendFlatMap[T1, T2 any](dst []T2, slice []T1, f func(T1) []T2) []T2 {
+	for i := range slice {
+		dst = append(dst, f(slice[i])...)
+	}
+	return dst
+}
+
+// スライスを平坦化してdstに追加したスライスを返す。
+func AppendFlatten[T any](dst []T, slice [][]T) []T {
+	for i := range slice {
+		dst = append(dst, slice[i]...)
+	}
+	return dst
+}
+
+// 条件を満たす要素を変換してdstに追加したスライスを返す。
+func AppendCollect[T1, T2 any](dst []T2, slice []T1, f func(T1) (T2, bool)) []T2 {
+	for i := range slice {
+		if v, ok := f(slice[i]); ok {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// 逆順にしてdstに追加したスライスを返す。
+func AppendReverse[T any](dst []T, slice []T) []T {
+	for i := len(slice) - 1; i >= 0; i-- {
+		dst = append(dst, slice[i])
+	}
+	return dst
+}
+
+// 初期値と要素を先頭から順に演算して途中経過をdstに追加したスライスを返す。
+func AppendScan[T1, T2 any](dst []T2, slice []T1, v T2, f func(T2, T1) T2) []T2 {
+	dst = append(dst, v)
+	for i := range slice {
+		v = f(v, slice[i])
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// ふたつのスライスの同じ位置の要素をペアにしてdstに追加したスライスを返す。
+// ふたつのスライスの要素数が異なる場合、小さいほうに合わせる。
+func AppendZip[T1, T2 any](dst []tuple.T2[T1, T2], slice1 []T1, slice2 []T2) []tuple.T2[T1, T2] {
+	size := len(slice1)
+	if size > len(slice2) {
+		size = len(slice2)
+	}
+	for i := 0; i < size; i++ {
+		dst = append(dst, tuple.NewT2(slice1[i], slice2[i]))
+	}
+	return dst
+}
@@ -0,0 +1,51 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/thamaji/slices/tuple"
+)
+
+func assertNoAllocs(t *testing.T, name string, f func()) {
+	t.Helper()
+	if n := testing.AllocsPerRun(100, f); n != 0 {
+		t.Errorf("%s: got %v allocs, want 0", name, n)
+	}
+}
+
+func TestAppendNoAllocs(t *testing.T) {
+	src := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	ints := make([]int, 0, 64)
+	pairs := make([]tuple.T2[int, int], 0, len(src))
+	nested := [][]int{src[:4], src[4:]}
+	chunk := []int{1, 2}
+
+	double := func(v int) int { return v * 2 }
+	even := func(v int) bool { return v%2 == 0 }
+	pair := func(v int) []int { return chunk }
+	half := func(v int) (int, bool) { return v / 2, v%2 == 0 }
+	sum := func(acc int, v int) int { return acc + v }
+
+	assertNoAllocs(t, "AppendMap", func() { ints = AppendMap(ints[:0], src, double) })
+	assertNoAllocs(t, "AppendFilter", func() { ints = AppendFilter(ints[:0], src, 3) })
+	assertNoAllocs(t, "AppendFilterBy", func() { ints = AppendFilterBy(ints[:0], src, even) })
+	assertNoAllocs(t, "AppendFilterNot", func() { ints = AppendFilterNot(ints[:0], src, 3) })
+	assertNoAllocs(t, "AppendFilterNotBy", func() { ints = AppendFilterNotBy(ints[:0], src, even) })
+	assertNoAllocs(t, "AppendFlatMap", func() { ints = AppendFlatMap(ints[:0], src, pair) })
+	assertNoAllocs(t, "AppendFlatten", func() { ints = AppendFlatten(ints[:0], nested) })
+	assertNoAllocs(t, "AppendCollect", func() { ints = AppendCollect(ints[:0], src, half) })
+	assertNoAllocs(t, "AppendReverse", func() { ints = AppendReverse(ints[:0], src) })
+	assertNoAllocs(t, "AppendScan", func() { ints = AppendScan(ints[:0], src, 0, sum) })
+	assertNoAllocs(t, "AppendZip", func() { pairs = AppendZip(pairs[:0], src, src) })
+}
+
+func TestAppendKeepsDst(t *testing.T) {
+	dst := AppendMap([]int{0}, []int{1, 2}, func(v int) int { return v * 10 })
+	if !Equal(dst, []int{0, 10, 20}) {
+		t.Errorf("AppendMap: got %v", dst)
+	}
+	dst = AppendReverse([]int{0}, []int{1, 2, 3})
+	if !Equal(dst, []int{0, 3, 2, 1}) {
+		t.Errorf("AppendReverse: got %v", dst)
+	}
+}
@@ -0,0 +1,181 @@
+package slices
+
+import "math/bits"
+
+// n個のビットを持つビットセットを返す。
+func NewBitSet(n int) BitSet {
+	return BitSet{n: n, words: make([]uint64, (n+63)/64)}
+}
+
+// boolのスライスをビットセットに変換する。
+func BitSetFromBools(slice []bool) BitSet {
+	b := NewBitSet(len(slice))
+	for i := range slice {
+		if slice[i] {
+			b.Set(i)
+		}
+	}
+	return b
+}
+
+// 条件を満たす要素の位置のビットを立てたビットセットを返す。
+func MaskBy[T any](slice []T, f func(T) bool) BitSet {
+	b := NewBitSet(len(slice))
+	for i := range slice {
+		if f(slice[i]) {
+			b.Set(i)
+		}
+	}
+	return b
+}
+
+// ビットの立っている位置の要素だけのスライスを返す。
+func Select[T any](slice []T, mask BitSet) []T {
+	dst := make([]T, 0, mask.Count())
+	for i, ok := mask.NextSet(0); ok && i < len(slice); i, ok = mask.NextSet(i + 1) {
+		dst = append(dst, slice[i])
+	}
+	return dst
+}
+
+// true の位置の要素だけのスライスを返す。
+func Compress[T any](slice []T, mask []bool) []T {
+	dst := make([]T, 0, len(slice))
+	for i := 0; i < len(slice) && i < len(mask); i++ {
+		if mask[i] {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 固定長のビットの集合。
+type BitSet struct {
+	n     int
+	words []uint64
+}
+
+// ビット数を返す。
+func (b BitSet) Len() int {
+	return b.n
+}
+
+// 指定した位置のビットを立てる。
+func (b BitSet) Set(i int) {
+	b.check(i)
+	b.words[i/64] |= 1 << (uint(i) % 64)
+}
+
+// 指定した位置のビットを下ろす。
+func (b BitSet) Clear(i int) {
+	b.check(i)
+	b.words[i/64] &^= 1 << (uint(i) % 64)
+}
+
+// 指定した位置のビットが立っていたらtrue。
+func (b BitSet) Test(i int) bool {
+	if i < 0 || i >= b.n {
+		return false
+	}
+	return b.words[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+// 立っているビットの数を返す。
+func (b BitSet) Count() int {
+	c := 0
+	for _, w := range b.words {
+		c += bits.OnesCount64(w)
+	}
+	return c
+}
+
+// 指定した位置以降で最初に立っているビットの位置を返す。
+func (b BitSet) NextSet(i int) (int, bool) {
+	if i < 0 {
+		i = 0
+	}
+	if i >= b.n {
+		return -1, false
+	}
+	k := i / 64
+	w := b.words[k] >> (uint(i) % 64)
+	if w != 0 {
+		return i + bits.TrailingZeros64(w), true
+	}
+	for k++; k < len(b.words); k++ {
+		if b.words[k] != 0 {
+			return k*64 + bits.TrailingZeros64(b.words[k]), true
+		}
+	}
+	return -1, false
+}
+
+// 論理積のビットセットを返す。
+// ビット数が異なる場合、大きいほうに合わせる。
+func (b BitSet) And(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x & y })
+}
+
+// 論理和のビットセットを返す。
+// ビット数が異なる場合、大きいほうに合わせる。
+func (b BitSet) Or(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// 排他的論理和のビットセットを返す。
+// ビット数が異なる場合、大きいほうに合わせる。
+func (b BitSet) Xor(other BitSet) BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// すべてのビットを反転したビットセットを返す。
+func (b BitSet) Not() BitSet {
+	dst := NewBitSet(b.n)
+	for i := range b.words {
+		dst.words[i] = ^b.words[i]
+	}
+	dst.trim()
+	return dst
+}
+
+// boolのスライスに変換する。
+func (b BitSet) Bools() []bool {
+	dst := make([]bool, b.n)
+	for i := range dst {
+		dst[i] = b.Test(i)
+	}
+	return dst
+}
+
+func (b BitSet) combine(other BitSet, f func(uint64, uint64) uint64) BitSet {
+	n := b.n
+	if n < other.n {
+		n = other.n
+	}
+	dst := NewBitSet(n)
+	var x, y uint64
+	for i := range dst.words {
+		x, y = 0, 0
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		dst.words[i] = f(x, y)
+	}
+	dst.trim()
+	return dst
+}
+
+func (b BitSet) trim() {
+	if r := uint(b.n) % 64; r != 0 {
+		b.words[len(b.words)-1] &= 1<<r - 1
+	}
+}
+
+func (b BitSet) check(i int) {
+	if i < 0 || i >= b.n {
+		panic("slices: bitset index out of range")
+	}
+}
@@ -0,0 +1,108 @@
+package slices
+
+// ふたつの値を比較する関数。
+// v1が前に並ぶなら負、等しければ0、後に並ぶなら正を返す。
+type Comparator[T any] func(v1 T, v2 T) int
+
+// 値の大小で比較するコンパレーターを返す。
+func Natural[T ordered]() Comparator[T] {
+	return func(v1, v2 T) int {
+		switch {
+		case v1 < v2:
+			return -1
+		case v1 > v2:
+			return 1
+		}
+		return 0
+	}
+}
+
+// 関数の返すキーの昇順で比較するコンパレーターを返す。
+func ComparatorBy[T any, K ordered](f func(T) K) Comparator[T] {
+	return ComparatorByFunc(f, Natural[K]())
+}
+
+// 関数の返すキーの降順で比較するコンパレーターを返す。
+func ComparatorByDesc[T any, K ordered](f func(T) K) Comparator[T] {
+	return ComparatorByFunc(f, Natural[K]().Reversed())
+}
+
+// 関数の返すキーをコンパレーターで比較するコンパレーターを返す。
+func ComparatorByFunc[T any, K any](f func(T) K, c Comparator[K]) Comparator[T] {
+	return func(v1, v2 T) int {
+		return c(f(v1), f(v2))
+	}
+}
+
+// 等しい場合に関数の返すキーの昇順で比較するコンパレーターを返す。
+func ThenBy[T any, K ordered](c Comparator[T], f func(T) K) Comparator[T] {
+	return c.Then(ComparatorBy(f))
+}
+
+// 等しい場合に関数の返すキーの降順で比較するコンパレーターを返す。
+func ThenByDesc[T any, K ordered](c Comparator[T], f func(T) K) Comparator[T] {
+	return c.Then(ComparatorByDesc(f))
+}
+
+// nilを先頭に並べるポインタのコンパレーターを返す。
+func NilsFirst[T any](c Comparator[T]) Comparator[*T] {
+	return func(v1, v2 *T) int {
+		switch {
+		case v1 == nil && v2 == nil:
+			return 0
+		case v1 == nil:
+			return -1
+		case v2 == nil:
+			return 1
+		}
+		return c(*v1, *v2)
+	}
+}
+
+// nilを末尾に並べるポインタのコンパレーターを返す。
+func NilsLast[T any](c Comparator[T]) Comparator[*T] {
+	return func(v1, v2 *T) int {
+		switch {
+		case v1 == nil && v2 == nil:
+			return 0
+		case v1 == nil:
+			return 1
+		case v2 == nil:
+			return -1
+		}
+		return c(*v1, *v2)
+	}
+}
+
+// 等しい場合にotherで比較するコンパレーターを返す。
+func (c Comparator[T]) Then(other Comparator[T]) Comparator[T] {
+	return func(v1, v2 T) int {
+		if r := c(v1, v2); r != 0 {
+			return r
+		}
+		return other(v1, v2)
+	}
+}
+
+// 逆順に比較するコンパレーターを返す。
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(v1, v2 T) int {
+		return c(v2, v1)
+	}
+}
+
+// v1がv2より前に並ぶ場合に true を返す関数を返す。
+// sort.Slice や MergeSortedBy に渡すことができる。
+func (c Comparator[T]) Less() func(T, T) bool {
+	return func(v1, v2 T) bool {
+		return c(v1, v2) < 0
+	}
+}
+
+// v1とv2が等しい場合に true を返す関数を返す。
+// UniqueBy、EqualBy、StartWithBy などに渡すことができる。
+func (c Comparator[T]) Equal() func(T, T) bool {
+	return func(v1, v2 T) bool {
+		return c(v1, v2) == 0
+	}
+}
@@ -0,0 +1,142 @@
+package slices
+
+import (
+	"sort"
+
+	"github.com/thamaji/slices/tuple"
+)
+
+// 要素ごとの出現回数のマップを返す。
+func Frequencies[T comparable](slice []T) map[T]int {
+	m := make(map[T]int, len(slice))
+	for i := range slice {
+		m[slice[i]]++
+	}
+	return m
+}
+
+// 要素ごとの出現回数を数えたカウンターを返す。
+func NewCounter[T comparable](slice []T) *Counter[T] {
+	c := &Counter[T]{counts: map[T]int{}}
+	for i := range slice {
+		c.Add(slice[i], 1)
+	}
+	return c
+}
+
+// 要素ごとの出現回数を保持する多重集合。
+// 要素は最初に追加された順序を保つ。ゼロ値のまま使うことができる。
+type Counter[T comparable] struct {
+	counts map[T]int
+	keys   []T
+}
+
+// 要素の出現回数を返す。
+func (c *Counter[T]) Get(v T) int {
+	return c.counts[v]
+}
+
+// 要素の出現回数にnを加える。
+func (c *Counter[T]) Add(v T, n int) {
+	if c.counts == nil {
+		c.counts = map[T]int{}
+	}
+	if _, ok := c.counts[v]; !ok {
+		c.keys = append(c.keys, v)
+	}
+	c.counts[v] += n
+}
+
+// 要素の出現回数からnを引く。
+// 出現回数は0以下になることもある。
+func (c *Counter[T]) Subtract(v T, n int) {
+	c.Add(v, -n)
+}
+
+// 出現回数の合計を返す。
+func (c *Counter[T]) Total() int {
+	total := 0
+	for _, n := range c.counts {
+		total += n
+	}
+	return total
+}
+
+// 出現回数の多い順にn個の要素と出現回数のペアを返す。
+// nが負の場合はすべての要素を返す。
+func (c *Counter[T]) MostCommon(n int) []tuple.T2[T, int] {
+	return c.entries(n, func(n1, n2 int) bool { return n1 > n2 })
+}
+
+// 出現回数の少ない順にn個の要素と出現回数のペアを返す。
+// nが負の場合はすべての要素を返す。
+func (c *Counter[T]) LeastCommon(n int) []tuple.T2[T, int] {
+	return c.entries(n, func(n1, n2 int) bool { return n1 < n2 })
+}
+
+// 要素を出現回数だけ繰り返したスライスを返す。
+// 出現回数が0以下の要素は含まない。
+func (c *Counter[T]) Elements() []T {
+	dst := []T{}
+	for _, k := range c.keys {
+		for i := 0; i < c.counts[k]; i++ {
+			dst = append(dst, k)
+		}
+	}
+	return dst
+}
+
+// 要素ごとに出現回数の大きいほうをとったカウンターを返す。
+func (c *Counter[T]) Union(other *Counter[T]) *Counter[T] {
+	dst := &Counter[T]{counts: map[T]int{}}
+	for _, k := range c.keys {
+		if n := c.counts[k]; n > 0 {
+			dst.Add(k, n)
+		}
+	}
+	for _, k := range other.keys {
+		if n := other.counts[k]; n > dst.counts[k] {
+			dst.Add(k, n-dst.counts[k])
+		}
+	}
+	return dst
+}
+
+// 要素ごとに出現回数の小さいほうをとったカウンターを返す。
+func (c *Counter[T]) Intersect(other *Counter[T]) *Counter[T] {
+	dst := &Counter[T]{counts: map[T]int{}}
+	for _, k := range c.keys {
+		n := c.counts[k]
+		if m := other.counts[k]; n > m {
+			n = m
+		}
+		if n > 0 {
+			dst.Add(k, n)
+		}
+	}
+	return dst
+}
+
+// 要素ごとに出現回数を引いたカウンターを返す。
+// 出現回数が0以下になった要素は含まない。
+func (c *Counter[T]) Difference(other *Counter[T]) *Counter[T] {
+	dst := &Counter[T]{counts: map[T]int{}}
+	for _, k := range c.keys {
+		if n := c.counts[k] - other.counts[k]; n > 0 {
+			dst.Add(k, n)
+		}
+	}
+	return dst
+}
+
+func (c *Counter[T]) entries(n int, less func(int, int) bool) []tuple.T2[T, int] {
+	dst := make([]tuple.T2[T, int], 0, len(c.keys))
+	for _, k := range c.keys {
+		dst = append(dst, tuple.NewT2(k, c.counts[k]))
+	}
+	sort.SliceStable(dst, func(i, j int) bool { return less(dst[i].V2, dst[j].V2) })
+	if n >= 0 && n < len(dst) {
+		dst = dst[:n]
+	}
+	return dst
+}
@@ -0,0 +1,15 @@
+package slices
+
+import "testing"
+
+func TestCounterZeroValue(t *testing.T) {
+	var c Counter[int]
+	if got := c.Get(1); got != 0 {
+		t.Errorf("Get: got %d", got)
+	}
+	c.Add(1, 2)
+	c.Subtract(2, 1)
+	if c.Get(1) != 2 || c.Get(2) != -1 || c.Total() != 1 {
+		t.Errorf("Counter: got %d %d %d", c.Get(1), c.Get(2), c.Total())
+	}
+}
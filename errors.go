@@ -0,0 +1,17 @@
+package slices
+
+import "errors"
+
+var (
+	// スライスの要素数が一致しないことを表すエラー。
+	ErrLengthMismatch = errors.New("slices: length mismatch")
+
+	// 増分が0であることを表すエラー。
+	ErrZeroStep = errors.New("slices: zero step")
+
+	// 連番の要素数が求まらないか大きすぎることを表すエラー。
+	ErrInvalidRange = errors.New("slices: invalid range")
+
+	// nilのポインタが含まれることを表すエラー。
+	ErrNilPointer = errors.New("slices: nil pointer")
+)
@@ -0,0 +1,97 @@
+package slices
+
+import (
+	"bytes"
+	"reflect"
+	"unsafe"
+)
+
+// 型に応じた高速な処理を使うスライスの最小の要素数。
+// 短いスライスでは型を調べる時間のほうが比較より長くなるため、単純なループで処理する。
+const fastPathMinLen = 16
+
+// reflect で要素の型を調べるスライスの最小の要素数。
+// reflect は型スイッチより遅いため、より長いスライスに限る。
+const reflectMinLen = 256
+
+// 整数か bool のスライスなら、要素のバイト数を返す。それ以外の型なら0を返す。
+// これらの型はビット列が等しいことと値が等しいことが一致するため、ビット列で比較できる。
+// 浮動小数点数は NaN や -0 があるため対象外。
+func intSize[T any](slice []T) uintptr {
+	switch any(slice).(type) {
+	case []bool, []int8, []uint8,
+		[]int16, []uint16,
+		[]int32, []uint32,
+		[]int64, []uint64,
+		[]int, []uint, []uintptr:
+		return unsafe.Sizeof(*new(T))
+	}
+	// 名前付きの型は型スイッチで判別できないため、長いスライスに限って reflect で調べる。
+	if len(slice) < reflectMinLen {
+		return 0
+	}
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsafe.Sizeof(*new(T))
+	}
+	return 0
+}
+
+// 要素のメモリをバイト列として見る。
+func viewBytes[T any](slice []T) []byte {
+	if len(slice) == 0 {
+		return []byte{}
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&slice[0])), len(slice)*int(unsafe.Sizeof(slice[0])))
+}
+
+// 値のビット列を別の型として読む。
+func bitsOf[W any, T any](v T) W {
+	return *(*W)(unsafe.Pointer(&v))
+}
+
+// 同じ要素数のスライスが一致していたらtrue。
+// 整数か bool のスライスはビット列で比較する。
+func equalFast[T comparable](slices1 []T, slices2 []T) bool {
+	if intSize(slices1) != 0 {
+		return bytes.Equal(viewBytes(slices1), viewBytes(slices2))
+	}
+	for i := range slices1 {
+		if slices1[i] != slices2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// 値と一致する最初の要素の位置を返す。
+// 1バイトの整数か bool のスライスはバイト列として探す。
+// それより大きい整数は、4要素ずつ比較しても単純なループより速くならなかった。
+func indexFast[T comparable](slice []T, v T) int {
+	if intSize(slice) == 1 {
+		return bytes.IndexByte(viewBytes(slice), bitsOf[byte](v))
+	}
+	for i := range slice {
+		if slice[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// 値と一致する要素の数を返す。
+// 1バイトの整数か bool のスライスはバイト列として数える。
+func countFast[T comparable](slice []T, v T) int {
+	if intSize(slice) == 1 {
+		return bytes.Count(viewBytes(slice), []byte{bitsOf[byte](v)})
+	}
+	c := 0
+	for i := range slice {
+		if slice[i] == v {
+			c++
+		}
+	}
+	return c
+}
@@ -0,0 +1,274 @@
+package slices
+
+import (
+	"math"
+	"testing"
+)
+
+type namedByte byte
+
+// 高速化する前の汎用的な実装。ベンチマークの比較対象にする。
+
+func equalLoop[T comparable](slices1 []T, slices2 []T) bool {
+	if len(slices1) != len(slices2) {
+		return false
+	}
+	for i := 0; i < len(slices1); i++ {
+		if slices1[i] != slices2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func indexLoop[T comparable](slice []T, v T) int {
+	for i := range slice {
+		if slice[i] == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func countLoop[T comparable](slice []T, v T) int {
+	c := 0
+	for i := range slice {
+		if slice[i] == v {
+			c++
+		}
+	}
+	return c
+}
+
+func fillLoop[T any](slice []T, v T) {
+	for i := range slice {
+		slice[i] = v
+	}
+}
+
+func TestFastPaths(t *testing.T) {
+	if !Equal([]namedByte{1, 2, 3}, []namedByte{1, 2, 3}) || Equal([]namedByte{1, 2, 3}, []namedByte{1, 2, 4}) {
+		t.Error("Equal: namedByte")
+	}
+	if !Equal([]int64{-1, 2, 3, 4, 5}, []int64{-1, 2, 3, 4, 5}) || Equal([]int64{1, 2, 3, 4, 5}, []int64{1, 2, 3, 4, 6}) {
+		t.Error("Equal: int64")
+	}
+	if !Equal([]bool{true, false}, []bool{true, false}) || Equal([]bool{true}, []bool{false}) {
+		t.Error("Equal: bool")
+	}
+	if nan := math.NaN(); Equal([]float64{nan}, []float64{nan}) || !Equal([]float64{0}, []float64{math.Copysign(0, -1)}) {
+		t.Error("Equal: float64 must follow == semantics")
+	}
+	if !Equal([]int{}, []int{}) {
+		t.Error("Equal: empty")
+	}
+
+	if got := Index([]namedByte{1, 2, 3}, 2); got != 1 {
+		t.Errorf("Index: namedByte got %d", got)
+	}
+	if got := Index([]int8{-1, -2, -3}, -3); got != 2 {
+		t.Errorf("Index: int8 got %d", got)
+	}
+	if got := Index([]int64{1, 2}, 3); got != -1 {
+		t.Errorf("Index: int64 got %d", got)
+	}
+	if got := Count([]bool{true, false, true}, true); got != 2 {
+		t.Errorf("Count: bool got %d", got)
+	}
+	if got := Count([]uint8{}, 1); got != 0 {
+		t.Errorf("Count: empty got %d", got)
+	}
+
+	long := FromFunc(reflectMinLen+3, func(i int) namedByte { return namedByte(i % 200) })
+	if !Equal(long, Clone(long)) || Index(long, 66) != 66 || Count(long, 66) != 1 || Index(long, 250) != -1 {
+		t.Error("namedByte: long slice")
+	}
+	for n := 0; n < 11; n++ {
+		i16 := FromFunc(n, func(i int) int16 { return int16(-i) })
+		i32 := FromFunc(n, func(i int) int32 { return int32(-i) })
+		i64 := FromFunc(n, func(i int) int64 { return int64(-i) })
+		for i := 0; i < n; i++ {
+			if Index(i16, int16(-i)) != i || Index(i32, int32(-i)) != i || Index(i64, int64(-i)) != i {
+				t.Errorf("Index: n=%d i=%d", n, i)
+			}
+		}
+		if Index(i64, 1) != -1 || !Contains(Push(i32, 7), 7) {
+			t.Errorf("Index: n=%d", n)
+		}
+		if got := Count(Push(i16, 5, 5), 5); got != 2 {
+			t.Errorf("Count: n=%d got %d", n, got)
+		}
+	}
+
+	for n := 0; n < 20; n++ {
+		slice := make([]int, n)
+		Fill(slice, 7)
+		if Count(slice, 7) != n {
+			t.Errorf("Fill: got %v", slice)
+		}
+	}
+}
+
+const benchmarkFastPathSize = 4096
+
+func benchmarkSlice[T any](f func(int) T) []T {
+	return FromFunc(benchmarkFastPathSize, f)
+}
+
+func BenchmarkEqualBytes(b *testing.B) {
+	s1 := benchmarkSlice(func(i int) byte { return byte(i) })
+	s2 := Clone(s1)
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			equalLoop(s1, s2)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Equal(s1, s2)
+		}
+	})
+}
+
+func BenchmarkEqualNamedByte(b *testing.B) {
+	s1 := benchmarkSlice(func(i int) namedByte { return namedByte(i) })
+	s2 := Clone(s1)
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			equalLoop(s1, s2)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Equal(s1, s2)
+		}
+	})
+}
+
+func BenchmarkEqualInt64(b *testing.B) {
+	s1 := benchmarkSlice(func(i int) int64 { return int64(i) })
+	s2 := Clone(s1)
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			equalLoop(s1, s2)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Equal(s1, s2)
+		}
+	})
+}
+
+func BenchmarkEqualFloat64(b *testing.B) {
+	s1 := benchmarkSlice(func(i int) float64 { return float64(i) })
+	s2 := Clone(s1)
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			equalLoop(s1, s2)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Equal(s1, s2)
+		}
+	})
+}
+
+func BenchmarkIndexNamedByte(b *testing.B) {
+	s := benchmarkSlice(func(i int) namedByte { return 0 })
+	s[len(s)-1] = 1
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			indexLoop(s, 1)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Index(s, 1)
+		}
+	})
+}
+
+func BenchmarkCountBytes(b *testing.B) {
+	s := benchmarkSlice(func(i int) byte { return byte(i % 7) })
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			countLoop(s, 3)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Count(s, 3)
+		}
+	})
+}
+
+func BenchmarkFillInt64(b *testing.B) {
+	s := make([]int64, benchmarkFastPathSize)
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			fillLoop(s, 7)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Fill(s, 7)
+		}
+	})
+}
+
+func BenchmarkIndexInt64(b *testing.B) {
+	s := benchmarkSlice(func(i int) int64 { return int64(i % 7) })
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			indexLoop(s, 7)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Index(s, 7)
+		}
+	})
+}
+
+func BenchmarkCountInt32(b *testing.B) {
+	s := benchmarkSlice(func(i int) int32 { return int32(i % 7) })
+	b.Run("loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			countLoop(s, 3)
+		}
+	})
+	b.Run("fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Count(s, 3)
+		}
+	})
+}
+
+// 短いスライスで型を調べる時間が目立たないことを確かめる。
+func BenchmarkShort(b *testing.B) {
+	strs := []string{"a", "b", "c", "d"}
+	ints := []int{1, 2, 3, 4}
+	ints2 := Clone(ints)
+	b.Run("ContainsString/loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			indexLoop(strs, "d")
+		}
+	})
+	b.Run("ContainsString/fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Contains(strs, "d")
+		}
+	})
+	b.Run("EqualInt/loop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			equalLoop(ints, ints2)
+		}
+	})
+	b.Run("EqualInt/fast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Equal(ints, ints2)
+		}
+	})
+}
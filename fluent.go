@@ -0,0 +1,107 @@
+package slices
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// メソッドをつなげて操作できるスライス。
+// []T とは型変換だけで相互に変換できる。
+// メソッドは元のスライスの要素を変更しない。
+// comparable な要素の重複排除は関数 UniqueSlice で行う。メソッドの UniqueBy は O(n²) になる。
+type Slice[T any] []T
+
+// 値をスライスに変換する。
+func SliceOf[T any](values ...T) Slice[T] {
+	return Slice[T](FromValue(values...))
+}
+
+// 値を変換したスライスを返す。
+func MapSlice[T1, T2 any](slice Slice[T1], f func(T1) T2) Slice[T2] {
+	return Map(slice, f)
+}
+
+// 値をスライスに変換し、それらを結合したスライスを返す。
+func FlatMapSlice[T1, T2 any](slice Slice[T1], f func(T1) []T2) Slice[T2] {
+	return FlatMap(slice, f)
+}
+
+// 条件を満たす要素を変換したスライスを返す。
+func CollectSlice[T1, T2 any](slice Slice[T1], f func(T1) (T2, bool)) Slice[T2] {
+	return Collect(slice, f)
+}
+
+// 重複を排除したスライスを返す。
+// 最初に現れた要素を残し、順序を保つ。
+func UniqueSlice[T comparable](slice Slice[T]) Slice[T] {
+	return Distinct(slice)
+}
+
+// 要素数を返す。
+func (s Slice[T]) Len() int {
+	return len(s)
+}
+
+// 条件を満たす要素だけのスライスを返す。
+func (s Slice[T]) Filter(f func(T) bool) Slice[T] {
+	return FilterBy(s, f)
+}
+
+// 条件を満たさない要素だけのスライスを返す。
+func (s Slice[T]) FilterNot(f func(T) bool) Slice[T] {
+	return FilterNotBy(s, f)
+}
+
+// 逆順にしたスライスを返す。
+func (s Slice[T]) Reverse() Slice[T] {
+	return Reverse(s)
+}
+
+// 先頭n個の要素を返す。
+// 返すスライスは元のスライスと要素を共有する。
+func (s Slice[T]) Take(n int) Slice[T] {
+	return Take(s, n)
+}
+
+// 先頭n個の要素を除いたスライスを返す。
+// 返すスライスは元のスライスと要素を共有する。
+func (s Slice[T]) Drop(n int) Slice[T] {
+	return Drop(s, n)
+}
+
+// 重複を排除したスライスを返す。
+// 最初に現れた要素を残し、順序を保つ。要素数の2乗に比例する時間がかかる。
+func (s Slice[T]) UniqueBy(f func(T, T) bool) Slice[T] {
+	return UniqueByInplace(Clone(s), f)
+}
+
+// 要素をソートしたスライスを返す。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func (s Slice[T]) Sort(less func(T, T) bool) Slice[T] {
+	dst := Clone(s)
+	sort.SliceStable(dst, func(i, j int) bool { return less(dst[i], dst[j]) })
+	return dst
+}
+
+// 要素をランダムに入れ替えたスライスを返す。
+func (s Slice[T]) Shuffle(r *rand.Rand) Slice[T] {
+	dst := Clone(s)
+	Shuffle(dst, r)
+	return dst
+}
+
+// 末尾に要素を追加したスライスを返す。
+// append と同じく、容量に余裕があれば元のスライスの後ろの領域に書き込む。
+func (s Slice[T]) Push(v ...T) Slice[T] {
+	return Push(s, v...)
+}
+
+// 要素をすべてコピーしたスライスを返す。
+func (s Slice[T]) Clone() Slice[T] {
+	return Clone(s)
+}
+
+// １つでも条件を満たす要素が存在したらtrue。
+func (s Slice[T]) ContainsBy(f func(T) bool) bool {
+	return ContainsBy(s, f)
+}
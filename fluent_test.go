@@ -0,0 +1,25 @@
+package slices
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSliceDoesNotMutate(t *testing.T) {
+	s := SliceOf(3, 1, 2, 5, 4)
+	want := s.Clone()
+
+	sorted := s.Sort(func(v1, v2 int) bool { return v1 < v2 })
+	if !Equal(sorted, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Sort: got %v", sorted)
+	}
+	shuffled := s.Shuffle(rand.New(rand.NewSource(1)))
+	if !EqualUnordered(shuffled, want) {
+		t.Errorf("Shuffle: got %v", shuffled)
+	}
+	s.Filter(func(v int) bool { return v > 2 }).Reverse().UniqueBy(func(v1, v2 int) bool { return v1 == v2 })
+
+	if !Equal(s, want) {
+		t.Errorf("receiver changed: got %v, want %v", s, want)
+	}
+}
@@ -0,0 +1,115 @@
+package slices
+
+// 初期値から関数で値を生成し続けたスライスを返す。
+// 関数は、生成した値と次の状態を返す。false を返したら終了する。
+func Unfold[S, T any](seed S, f func(S) (T, S, bool)) []T {
+	dst := []T{}
+	for {
+		v, next, ok := f(seed)
+		if !ok {
+			return dst
+		}
+		dst = append(dst, v)
+		seed = next
+	}
+}
+
+// x、f(x)、f(f(x))...とn個の値を並べたスライスを返す。
+func Iterate[T any](x T, f func(T) T, n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	dst := make([]T, 0, n)
+	dst = append(dst, x)
+	for i := 1; i < n; i++ {
+		x = f(x)
+		dst = append(dst, x)
+	}
+	return dst
+}
+
+// 関数の実行結果が条件を満たす間、結果を並べたスライスを返す。
+func GenerateWhile[T any](f func(int) T, cond func(T) bool) []T {
+	dst := []T{}
+	for i := 0; ; i++ {
+		v := f(i)
+		if !cond(v) {
+			return dst
+		}
+		dst = append(dst, v)
+	}
+}
+
+// 初期値から関数で値を生成し続ける関数を返す。
+// 関数は、値があれば true を、終端に達したら false を返す。
+func UnfoldIter[S, T any](seed S, f func(S) (T, S, bool)) func() (T, bool) {
+	done := false
+	return func() (T, bool) {
+		if done {
+			return *new(T), false
+		}
+		v, next, ok := f(seed)
+		if !ok {
+			done = true
+			return *new(T), false
+		}
+		seed = next
+		return v, true
+	}
+}
+
+// x、f(x)、f(f(x))...と値を無限に返す関数を返す。
+func IterateIter[T any](x T, f func(T) T) func() (T, bool) {
+	first := true
+	return func() (T, bool) {
+		if first {
+			first = false
+		} else {
+			x = f(x)
+		}
+		return x, true
+	}
+}
+
+// 値を無限に返す関数を返す。
+func RepeatIter[T any](v T) func() (T, bool) {
+	return func() (T, bool) {
+		return v, true
+	}
+}
+
+// スライスの要素を無限に繰り返し返す関数を返す。
+// スライスが空の場合は false を返す。
+func CycleIter[T any](slice []T) func() (T, bool) {
+	i := 0
+	return func() (T, bool) {
+		if len(slice) == 0 {
+			return *new(T), false
+		}
+		v := slice[i]
+		i = (i + 1) % len(slice)
+		return v, true
+	}
+}
+
+// 関数の返す値を先頭からn個並べたスライスを返す。
+func TakeIter[T any](next func() (T, bool), n int) []T {
+	dst := []T{}
+	for i := 0; i < n; i++ {
+		v, ok := next()
+		if !ok {
+			break
+		}
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// 関数の返す値が条件を満たす間、値を並べたスライスを返す。
+func TakeWhileIter[T any](next func() (T, bool), f func(T) bool) []T {
+	dst := []T{}
+	for v, ok := next(); ok && f(v); v, ok = next() {
+		dst = append(dst, v)
+	}
+	return dst
+}
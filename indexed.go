@@ -0,0 +1,106 @@
+package slices
+
+// 要素を先頭から順に関数に渡す。
+func ForEach[T any](slice []T, f func(T)) {
+	for i := range slice {
+		f(slice[i])
+	}
+}
+
+// 要素の位置と要素を先頭から順に関数に渡す。
+func ForEachIndexed[T any](slice []T, f func(int, T)) {
+	for i := range slice {
+		f(i, slice[i])
+	}
+}
+
+// 要素の位置と要素を先頭から順に関数に渡す。関数が false を返したら終了する。
+func ForEachWhile[T any](slice []T, f func(int, T) bool) {
+	for i := range slice {
+		if !f(i, slice[i]) {
+			return
+		}
+	}
+}
+
+// 要素の位置と要素から値を変換したスライスを返す。
+func MapIndexed[T1, T2 any](slice []T1, f func(int, T1) T2) []T2 {
+	dst := make([]T2, len(slice))
+	for i := range slice {
+		dst[i] = f(i, slice[i])
+	}
+	return dst
+}
+
+// 要素の位置と要素が条件を満たす要素だけのスライスを返す。
+func FilterIndexed[T any](slice []T, f func(int, T) bool) []T {
+	dst := make([]T, 0, len(slice))
+	for i := range slice {
+		if f(i, slice[i]) {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 要素の位置と要素が条件を満たさない要素だけのスライスを返す。
+func FilterNotIndexed[T any](slice []T, f func(int, T) bool) []T {
+	dst := make([]T, 0, len(slice))
+	for i := range slice {
+		if !f(i, slice[i]) {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 要素の位置と要素が条件を満たす要素を変換したスライスを返す。
+func CollectIndexed[T1, T2 any](slice []T1, f func(int, T1) (T2, bool)) []T2 {
+	dst := make([]T2, 0, len(slice))
+	for i := range slice {
+		if v, ok := f(i, slice[i]); ok {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+// 初期値と要素の位置と要素を先頭から順に演算する。
+func FoldIndexed[T1, T2 any](slice []T1, v T2, f func(T2, int, T1) T2) T2 {
+	for i := range slice {
+		v = f(v, i, slice[i])
+	}
+	return v
+}
+
+// 要素の位置と要素が条件を満たす要素の数を返す。
+func CountByIndexed[T any](slice []T, f func(int, T) bool) int {
+	c := 0
+	for i := range slice {
+		if f(i, slice[i]) {
+			c++
+		}
+	}
+	return c
+}
+
+// 要素の位置と要素が条件を満たす最初の要素の位置を返す。
+func IndexByIndexed[T any](slice []T, f func(int, T) bool) int {
+	for i := range slice {
+		if f(i, slice[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// 要素の位置と要素から関数の返すキーでグルーピングしたマップを返す。
+func GroupByIndexed[T1 any, T2 comparable](slice []T1, f func(int, T1) T2) map[T2][]T1 {
+	m := map[T2][]T1{}
+	var k T2
+	for i := range slice {
+		k = f(i, slice[i])
+		m[k] = append(m[k], slice[i])
+	}
+	return m
+}
@@ -0,0 +1,116 @@
+package slices
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// 行ごとに読み込んだスライスを返す。
+// maxTokenSize が 0 以下の場合は bufio.MaxScanTokenSize を上限にする。
+func FromLines(r io.Reader, maxTokenSize int) ([]string, error) {
+	return FromScanner(newScanner(r, bufio.ScanLines, maxTokenSize))
+}
+
+// 区切り文字ごとに読み込んだスライスを返す。
+// maxTokenSize が 0 以下の場合は bufio.MaxScanTokenSize を上限にする。
+func FromDelimited(r io.Reader, sep byte, maxTokenSize int) ([]string, error) {
+	return FromScanner(newScanner(r, scanDelimited(sep), maxTokenSize))
+}
+
+// スキャナーで読み込んだトークンのスライスを返す。
+func FromScanner(s *bufio.Scanner) ([]string, error) {
+	dst := []string{}
+	for s.Scan() {
+		dst = append(dst, s.Text())
+	}
+	return dst, s.Err()
+}
+
+// スキャナーで読み込んだトークンをn個ずつ返す関数を返す。
+// 関数は、読み込みを終えると io.EOF を返す。
+// nが0以下の場合はpanicする。
+func FromScannerChunked(s *bufio.Scanner, n int) func() ([]string, error) {
+	if n <= 0 {
+		panic("slices: chunk size must be positive")
+	}
+	done := false
+	return func() ([]string, error) {
+		if done {
+			return []string{}, io.EOF
+		}
+		dst := make([]string, 0, n)
+		for len(dst) < n {
+			if !s.Scan() {
+				done = true
+				if err := s.Err(); err != nil {
+					return dst, err
+				}
+				if len(dst) == 0 {
+					return dst, io.EOF
+				}
+				break
+			}
+			dst = append(dst, s.Text())
+		}
+		return dst, nil
+	}
+}
+
+// 要素を文字列に変換して1行ずつ書き込む。
+func WriteLines[T any](w io.Writer, slice []T, f func(T) string) error {
+	bw := bufio.NewWriter(w)
+	for i := range slice {
+		if _, err := bw.WriteString(f(slice[i])); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// 要素を文字列に変換して区切り文字を挟んで書き込む。
+func WriteJoined[T any](w io.Writer, slice []T, sep string, f func(T) string) error {
+	bw := bufio.NewWriter(w)
+	for i := range slice {
+		if i > 0 {
+			if _, err := bw.WriteString(sep); err != nil {
+				return err
+			}
+		}
+		if _, err := bw.WriteString(f(slice[i])); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+func newScanner(r io.Reader, split bufio.SplitFunc, maxTokenSize int) *bufio.Scanner {
+	s := bufio.NewScanner(r)
+	s.Split(split)
+	if maxTokenSize > 0 {
+		size := 4096
+		if size > maxTokenSize {
+			size = maxTokenSize
+		}
+		s.Buffer(make([]byte, 0, size), maxTokenSize)
+	}
+	return s
+}
+
+func scanDelimited(sep byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, sep); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
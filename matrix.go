@@ -0,0 +1,281 @@
+package slices
+
+import "math"
+
+// 行と列を入れ替えたスライスを返す。
+// 行の要素数が異なる場合、最も小さいものに合わせる。
+func Transpose[T any](matrix [][]T) [][]T {
+	cols := minCols(matrix)
+	dst := make([][]T, cols)
+	for j := 0; j < cols; j++ {
+		dst[j] = make([]T, len(matrix))
+		for i := range matrix {
+			dst[j][i] = matrix[i][j]
+		}
+	}
+	return dst
+}
+
+// 行と列を入れ替えたスライスを返す。
+// 行の要素数が異なる場合、最も大きいものに合わせて足りない要素をvで埋める。
+func TransposeFill[T any](matrix [][]T, v T) [][]T {
+	cols := maxCols(matrix)
+	dst := make([][]T, cols)
+	for j := 0; j < cols; j++ {
+		dst[j] = make([]T, len(matrix))
+		for i := range matrix {
+			if j < len(matrix[i]) {
+				dst[j][i] = matrix[i][j]
+			} else {
+				dst[j][i] = v
+			}
+		}
+	}
+	return dst
+}
+
+// 時計回りに90度回転したスライスを返す。
+// 行の要素数が異なる場合、最も小さいものに合わせる。
+func Rotate90[T any](matrix [][]T) [][]T {
+	cols := minCols(matrix)
+	dst := make([][]T, cols)
+	for j := 0; j < cols; j++ {
+		dst[j] = make([]T, len(matrix))
+		for i := range matrix {
+			dst[j][len(matrix)-i-1] = matrix[i][j]
+		}
+	}
+	return dst
+}
+
+// 180度回転したスライスを返す。
+func Rotate180[T any](matrix [][]T) [][]T {
+	dst := make([][]T, len(matrix))
+	for i := range matrix {
+		dst[len(matrix)-i-1] = Reverse(matrix[i])
+	}
+	return dst
+}
+
+// 時計回りに270度回転したスライスを返す。
+// 行の要素数が異なる場合、最も小さいものに合わせる。
+func Rotate270[T any](matrix [][]T) [][]T {
+	cols := minCols(matrix)
+	dst := make([][]T, cols)
+	for j := 0; j < cols; j++ {
+		dst[cols-j-1] = make([]T, len(matrix))
+		for i := range matrix {
+			dst[cols-j-1][i] = matrix[i][j]
+		}
+	}
+	return dst
+}
+
+// スライスをrows行cols列のスライスに変換する。
+// rows*colsが要素数と一致しない場合はpanicする。
+func Reshape[T any](slice []T, rows int, cols int) [][]T {
+	// rows*cols はオーバーフローしうるため、割り算で比べる。
+	if rows < 0 || cols < 0 || (cols == 0 && len(slice) != 0) || (cols != 0 && (len(slice)%cols != 0 || rows != len(slice)/cols)) {
+		panic("slices: rows*cols must equal len(slice)")
+	}
+	data := Clone(slice)
+	dst := make([][]T, rows)
+	for i := range dst {
+		dst[i] = data[i*cols : (i+1)*cols : (i+1)*cols]
+	}
+	return dst
+}
+
+// 指定した行を返す。
+func Row[T any](matrix [][]T, index int) ([]T, bool) {
+	if index < 0 || index >= len(matrix) {
+		return nil, false
+	}
+	return matrix[index], true
+}
+
+// 指定した列を返す。
+// 列が存在しない行がある場合は false を返す。
+func Column[T any](matrix [][]T, index int) ([]T, bool) {
+	if index < 0 {
+		return nil, false
+	}
+	dst := make([]T, len(matrix))
+	for i := range matrix {
+		if index >= len(matrix[i]) {
+			return nil, false
+		}
+		dst[i] = matrix[i][index]
+	}
+	return dst, true
+}
+
+// 指定した行の要素を置き換える。
+// 要素数が異なる場合、小さいほうに合わせる。行が存在しない場合は false を返す。
+func SetRow[T any](matrix [][]T, index int, row []T) bool {
+	if index < 0 || index >= len(matrix) {
+		return false
+	}
+	copy(matrix[index], row)
+	return true
+}
+
+// 指定した列の要素を置き換える。
+// 要素数が異なる場合、小さいほうに合わせる。列が存在しない行は無視する。
+// 列がどの行にも存在しない場合は false を返す。
+func SetColumn[T any](matrix [][]T, index int, column []T) bool {
+	if index < 0 || index >= maxCols(matrix) {
+		return false
+	}
+	for i := 0; i < len(matrix) && i < len(column); i++ {
+		if index < len(matrix[i]) {
+			matrix[i][index] = column[i]
+		}
+	}
+	return true
+}
+
+// 指定した位置からrows行cols列を切り出したスライスを返す。
+// 範囲が行列からはみ出す場合は false を返す。
+func SubMatrix[T any](matrix [][]T, row int, col int, rows int, cols int) ([][]T, bool) {
+	if row < 0 || col < 0 || rows < 0 || cols < 0 || row+rows > len(matrix) {
+		return nil, false
+	}
+	dst := make([][]T, rows)
+	for i := range dst {
+		if col+cols > len(matrix[row+i]) {
+			return nil, false
+		}
+		dst[i] = Clone(matrix[row+i][col : col+cols])
+	}
+	return dst, true
+}
+
+func minCols[T any](matrix [][]T) int {
+	if len(matrix) == 0 {
+		return 0
+	}
+	cols := len(matrix[0])
+	for i := 1; i < len(matrix); i++ {
+		if cols > len(matrix[i]) {
+			cols = len(matrix[i])
+		}
+	}
+	return cols
+}
+
+func maxCols[T any](matrix [][]T) int {
+	cols := 0
+	for i := range matrix {
+		if cols < len(matrix[i]) {
+			cols = len(matrix[i])
+		}
+	}
+	return cols
+}
+
+// rows行cols列のグリッドを返す。
+// rowsかcolsが負の場合や、rows*colsが int に収まらない場合はpanicする。
+func NewGrid[T any](rows int, cols int) Grid[T] {
+	if rows < 0 || cols < 0 {
+		panic("slices: grid size must not be negative")
+	}
+	if cols != 0 && rows > math.MaxInt/cols {
+		panic("slices: grid size too large")
+	}
+	return Grid[T]{rows: rows, cols: cols, data: make([]T, rows*cols)}
+}
+
+// スライスをグリッドに変換する。
+// 行の要素数が異なる場合、最も小さいものに合わせる。
+func GridFrom[T any](matrix [][]T) Grid[T] {
+	cols := minCols(matrix)
+	grid := NewGrid[T](len(matrix), cols)
+	for i := range matrix {
+		copy(grid.data[i*cols:(i+1)*cols], matrix[i])
+	}
+	return grid
+}
+
+// ひとつの連続したスライスに行優先で要素を格納するグリッド。
+type Grid[T any] struct {
+	rows int
+	cols int
+	data []T
+}
+
+// 行数を返す。
+func (g Grid[T]) Rows() int {
+	return g.rows
+}
+
+// 列数を返す。
+func (g Grid[T]) Cols() int {
+	return g.cols
+}
+
+// 指定した位置の要素を返す。
+// 範囲外の場合はスライスの添字と同じくpanicする。
+func (g Grid[T]) At(row int, col int) T {
+	return g.data[g.index(row, col)]
+}
+
+// 指定した位置に値を代入する。
+// 範囲外の場合はスライスの添字と同じくpanicする。
+func (g Grid[T]) Set(row int, col int, v T) {
+	g.data[g.index(row, col)] = v
+}
+
+// 指定した行を返す。
+// 返すスライスはグリッドと要素を共有する。
+// 範囲外の場合はスライスの添字と同じくpanicする。
+func (g Grid[T]) Row(row int) []T {
+	if row < 0 || row >= g.rows {
+		panic("slices: grid index out of range")
+	}
+	start := row * g.cols
+	return g.data[start : start+g.cols : start+g.cols]
+}
+
+// 指定した列をコピーしたスライスを返す。
+// 範囲外の場合はスライスの添字と同じくpanicする。
+func (g Grid[T]) Column(col int) []T {
+	dst := make([]T, g.rows)
+	for i := range dst {
+		dst[i] = g.data[g.index(i, col)]
+	}
+	return dst
+}
+
+// 行優先で並んだ要素のスライスを返す。
+// 返すスライスはグリッドと要素を共有する。
+func (g Grid[T]) Data() []T {
+	return g.data
+}
+
+// 行と列を入れ替えたグリッドを返す。
+func (g Grid[T]) Transpose() Grid[T] {
+	dst := NewGrid[T](g.cols, g.rows)
+	for i := 0; i < g.rows; i++ {
+		for j := 0; j < g.cols; j++ {
+			dst.data[j*g.rows+i] = g.data[i*g.cols+j]
+		}
+	}
+	return dst
+}
+
+// 要素をコピーしたスライスに変換する。
+func (g Grid[T]) Slices() [][]T {
+	dst := make([][]T, g.rows)
+	for i := range dst {
+		dst[i] = Clone(g.Row(i))
+	}
+	return dst
+}
+
+func (g Grid[T]) index(row int, col int) int {
+	if row < 0 || row >= g.rows || col < 0 || col >= g.cols {
+		panic("slices: grid index out of range")
+	}
+	return row*g.cols + col
+}
@@ -0,0 +1,132 @@
+package slices
+
+import "container/heap"
+
+// ソート済みのふたつのスライスをマージしたスライスを返す。
+func MergeSorted[T ordered](slice1 []T, slice2 []T) []T {
+	return MergeSortedBy(slice1, slice2, func(v1, v2 T) bool { return v1 < v2 })
+}
+
+// ソート済みのふたつのスライスをマージしたスライスを返す。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func MergeSortedBy[T any](slice1 []T, slice2 []T, less func(T, T) bool) []T {
+	dst := make([]T, 0, len(slice1)+len(slice2))
+	i, j := 0, 0
+	for i < len(slice1) && j < len(slice2) {
+		if less(slice2[j], slice1[i]) {
+			dst = append(dst, slice2[j])
+			j++
+		} else {
+			dst = append(dst, slice1[i])
+			i++
+		}
+	}
+	dst = append(dst, slice1[i:]...)
+	dst = append(dst, slice2[j:]...)
+	return dst
+}
+
+// ソート済みの複数のスライスをマージしたスライスを返す。
+func MergeSortedK[T ordered](slices ...[]T) []T {
+	return MergeSortedKBy(func(v1, v2 T) bool { return v1 < v2 }, slices...)
+}
+
+// ソート済みの複数のスライスをマージしたスライスを返す。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func MergeSortedKBy[T any](less func(T, T) bool, slices ...[]T) []T {
+	size := 0
+	for i := range slices {
+		size += len(slices[i])
+	}
+	dst := make([]T, 0, size)
+	next := MergeSortedIter(less, slices...)
+	for v, ok := next(); ok; v, ok = next() {
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// ソート済みの複数のスライスを重複を排除しながらマージしたスライスを返す。
+func MergeSortedUnique[T ordered](slices ...[]T) []T {
+	return MergeSortedUniqueBy(func(v1, v2 T) bool { return v1 < v2 }, slices...)
+}
+
+// ソート済みの複数のスライスを重複を排除しながらマージしたスライスを返す。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func MergeSortedUniqueBy[T any](less func(T, T) bool, slices ...[]T) []T {
+	dst := []T{}
+	next := MergeSortedIter(less, slices...)
+	for v, ok := next(); ok; v, ok = next() {
+		if len(dst) > 0 && !less(dst[len(dst)-1], v) {
+			continue
+		}
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// ソート済みの複数のスライスをマージしながら要素を順に返す関数を返す。
+// 関数は、値があれば true を、終端に達したら false を返す。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func MergeSortedIter[T any](less func(T, T) bool, slices ...[]T) func() (T, bool) {
+	h := &mergeHeap[T]{less: less}
+	for i := range slices {
+		if len(slices[i]) > 0 {
+			h.items = append(h.items, mergeItem[T]{slice: slices[i], order: i})
+		}
+	}
+	heap.Init(h)
+	return func() (T, bool) {
+		if h.Len() == 0 {
+			return *new(T), false
+		}
+		top := &h.items[0]
+		v := top.slice[0]
+		top.slice = top.slice[1:]
+		if len(top.slice) == 0 {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+		return v, true
+	}
+}
+
+type mergeItem[T any] struct {
+	slice []T
+	order int
+}
+
+type mergeHeap[T any] struct {
+	items []mergeItem[T]
+	less  func(T, T) bool
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	v1, v2 := h.items[i].slice[0], h.items[j].slice[0]
+	if h.less(v1, v2) {
+		return true
+	}
+	if h.less(v2, v1) {
+		return false
+	}
+	return h.items[i].order < h.items[j].order
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(mergeItem[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	item := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return item
+}
@@ -0,0 +1,45 @@
+package slices
+
+import (
+	"math/bits"
+	"sync"
+)
+
+const poolClasses = 32
+
+// 容量ごとに分類してスライスを再利用するプール。
+// ゼロ値のまま使うことができる。
+type Pool[T any] struct {
+	pools [poolClasses]sync.Pool
+}
+
+// 容量がn以上で要素数が0のスライスを返す。
+func (p *Pool[T]) Get(n int) []T {
+	if n <= 0 {
+		n = 1
+	}
+	class := bits.Len(uint(n - 1))
+	if class >= poolClasses {
+		return make([]T, 0, n)
+	}
+	if v := p.pools[class].Get(); v != nil {
+		return (*v.(*[]T))[:0]
+	}
+	return make([]T, 0, 1<<class)
+}
+
+// スライスをプールに戻す。
+// 戻したスライスは要素をゼロ値にするため、以降は使ってはならない。
+func (p *Pool[T]) Put(slice []T) {
+	if cap(slice) == 0 {
+		return
+	}
+	class := bits.Len(uint(cap(slice))) - 1
+	if class >= poolClasses {
+		return
+	}
+	slice = slice[:cap(slice)]
+	FillZero(slice)
+	slice = slice[:0]
+	p.pools[class].Put(&slice)
+}
@@ -0,0 +1,7 @@
+// safe は slices のうち不正な引数で panic する関数を、エラーを返すようにしたもの。
+//
+// nil の関数や *rand.Rand を渡した場合の panic は対象外。
+// 整数のオーバーフローや浮動小数点数の 0 除算は panic しないため、ラッパーを用意しない。
+// 要素数の積が int に収まらない場合はエラーを返すが、
+// 確保できないほど大きな要素数を渡した場合の panic やメモリ不足は対象外。
+package safe
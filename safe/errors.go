@@ -0,0 +1,73 @@
+package safe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// 位置が範囲外であることを表すエラー。
+	ErrIndexOutOfRange = errors.New("safe: index out of range")
+
+	// スライスが空であることを表すエラー。
+	ErrEmpty = errors.New("safe: empty slice")
+
+	// 個数や大きさが不正であることを表すエラー。
+	ErrInvalidSize = errors.New("safe: invalid size")
+
+	// 整数を0で割ろうとしたことを表すエラー。
+	ErrDivideByZero = errors.New("safe: integer divide by zero")
+)
+
+// 範囲外の位置を表すエラー。
+// errors.Is で ErrIndexOutOfRange と一致する。
+type IndexError struct {
+	Index int
+	Len   int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("safe: index %d out of range [0:%d]", e.Index, e.Len)
+}
+
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// 不正な個数や大きさを表すエラー。
+// errors.Is で ErrInvalidSize と一致する。
+type SizeError struct {
+	Name string
+	Size int
+}
+
+func (e *SizeError) Error() string {
+	return fmt.Sprintf("safe: invalid %s %d", e.Name, e.Size)
+}
+
+func (e *SizeError) Unwrap() error {
+	return ErrInvalidSize
+}
+
+func checkIndex(index int, size int) error {
+	if index < 0 || index >= size {
+		return &IndexError{Index: index, Len: size}
+	}
+	return nil
+}
+
+func checkSize(name string, n int, min int) error {
+	if n < min {
+		return &SizeError{Name: name, Size: n}
+	}
+	return nil
+}
+
+// 0以上の n*m が int に収まらない場合にエラーを返す。
+func checkProduct(name string, n int, m int) error {
+	if m != 0 && n > math.MaxInt/m {
+		return &SizeError{Name: name, Size: n}
+	}
+	return nil
+}
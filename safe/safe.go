@@ -0,0 +1,367 @@
+package safe
+
+import (
+	"bufio"
+	"math/rand"
+
+	"github.com/thamaji/slices"
+)
+
+// 指定した値をn個複製したスライスを返す。
+func Repeat[T any](n int, v T) ([]T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	return slices.Repeat(n, v), nil
+}
+
+// 関数をn回実行した結果のスライスを返す。
+func RepeatBy[T any](n int, f func() T) ([]T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	return slices.RepeatBy(n, f), nil
+}
+
+// 関数をn回実行した結果をスライスに変換する。
+func FromFunc[T any](n int, f func(int) T) ([]T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	return slices.FromFunc(n, f), nil
+}
+
+// スキャナーで読み込んだトークンをn個ずつ返す関数を返す。
+func FromScannerChunked(s *bufio.Scanner, n int) (func() ([]string, error), error) {
+	if err := checkSize("n", n, 1); err != nil {
+		return nil, err
+	}
+	return slices.FromScannerChunked(s, n), nil
+}
+
+// 指定した位置の要素を返す。
+func Get[T any](slice []T, index int) (T, error) {
+	if err := checkIndex(index, len(slice)); err != nil {
+		return *new(T), err
+	}
+	return slice[index], nil
+}
+
+// 指定した位置に要素を追加する。
+func Insert[T any](slice []T, index int, v ...T) ([]T, error) {
+	if err := checkIndex(index, len(slice)+1); err != nil {
+		return nil, err
+	}
+	return slices.Insert(slice, index, v...), nil
+}
+
+// 末尾からn個の要素を取り出す。
+func PopN[T any](slice []T, n int) ([]T, []T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, nil, err
+	}
+	v, rest := slices.PopN(slice, n)
+	return v, rest, nil
+}
+
+// 先頭からn個の要素を取り出す。
+func PopBackN[T any](slice []T, n int) ([]T, []T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, nil, err
+	}
+	v, rest := slices.PopBackN(slice, n)
+	return v, rest, nil
+}
+
+// 指定した位置の要素を削除する。
+func Remove[T any](slice []T, index int) ([]T, error) {
+	if err := checkIndex(index, len(slice)); err != nil {
+		return nil, err
+	}
+	return slices.Remove(slice, index), nil
+}
+
+// 指定した位置からn個の要素を削除する。
+func RemoveN[T any](slice []T, index int, n int) ([]T, error) {
+	if err := checkIndex(index, len(slice)+1); err != nil {
+		return nil, err
+	}
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	return slices.RemoveN(slice, index, n), nil
+}
+
+// 指定した位置の要素を入れ替える。
+func Swap[T any](slice []T, i int, j int) error {
+	if err := checkIndex(i, len(slice)); err != nil {
+		return err
+	}
+	if err := checkIndex(j, len(slice)); err != nil {
+		return err
+	}
+	slices.Swap(slice, i, j)
+	return nil
+}
+
+// 指定した位置の要素を移動する。他の要素の順序は保たれる。
+func Move[T any](slice []T, from int, to int) ([]T, error) {
+	if err := checkIndex(from, len(slice)); err != nil {
+		return nil, err
+	}
+	if err := checkIndex(to, len(slice)); err != nil {
+		return nil, err
+	}
+	return slices.Move(slice, from, to), nil
+}
+
+// 要素を１つランダムに返す。
+func Sample[T any](slice []T, r *rand.Rand) (T, error) {
+	if len(slice) == 0 {
+		return *new(T), ErrEmpty
+	}
+	return slices.Sample(slice, r), nil
+}
+
+// スライスをn回繰り返したスライスを返す。
+func Cycle[T any](slice []T, n int) ([]T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	if err := checkProduct("n", n, len(slice)); err != nil {
+		return nil, err
+	}
+	return slices.Cycle(slice, n), nil
+}
+
+// スライスをn個ずつ分割したスライスを返す。
+func Grouped[T any](slice []T, n int) ([][]T, error) {
+	if err := checkSize("n", n, 1); err != nil {
+		return nil, err
+	}
+	return slices.Grouped(slice, n), nil
+}
+
+// 先頭n個の要素を返す。
+func Take[T any](slice []T, n int) ([]T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	return slices.Take(slice, n), nil
+}
+
+// 先頭n個の要素を除いたスライスを返す。
+func Drop[T any](slice []T, n int) ([]T, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return nil, err
+	}
+	return slices.Drop(slice, n), nil
+}
+
+// 同じ位置の要素を割ったスライスを返す。
+// 整数を0で割ろうとした場合は ErrDivideByZero を返す。
+func Div[T number](slice1 []T, slice2 []T) ([]T, error) {
+	if len(slice1) == len(slice2) && isInteger[T]() {
+		for i := range slice2 {
+			if slice2[i] == 0 {
+				return nil, ErrDivideByZero
+			}
+		}
+	}
+	return slices.Div(slice1, slice2)
+}
+
+func isInteger[T number]() bool {
+	var v T = 1
+	return v/2 == 0
+}
+
+// スライスを要素数がほぼ等しいn個の連続した部分に分割したスライスを返す。
+func SplitN[T any](slice []T, n int) ([][]T, error) {
+	if err := checkSize("n", n, 1); err != nil {
+		return nil, err
+	}
+	return slices.SplitN(slice, n), nil
+}
+
+// 要素を先頭から順にn個のスライスに振り分ける。
+func Distribute[T any](slice []T, n int) ([][]T, []int, error) {
+	if err := checkSize("n", n, 1); err != nil {
+		return nil, nil, err
+	}
+	dst, mapping := slices.Distribute(slice, n)
+	return dst, mapping, nil
+}
+
+// 要素を関数の返すキーのハッシュでn個のスライスに振り分ける。
+func ShardBy[T any](slice []T, n int, f func(T) string) ([][]T, []int, error) {
+	if err := checkSize("n", n, 1); err != nil {
+		return nil, nil, err
+	}
+	dst, mapping := slices.ShardBy(slice, n, f)
+	return dst, mapping, nil
+}
+
+// 関数の返す重みの合計がなるべく均等になるように要素をn個のスライスに振り分ける。
+func BalanceBy[T any, W number](slice []T, n int, f func(T) W) ([][]T, []int, error) {
+	if err := checkSize("n", n, 1); err != nil {
+		return nil, nil, err
+	}
+	dst, mapping := slices.BalanceBy(slice, n, f)
+	return dst, mapping, nil
+}
+
+// スライスをrows行cols列のスライスに変換する。
+func Reshape[T any](slice []T, rows int, cols int) ([][]T, error) {
+	if err := checkSize("rows", rows, 0); err != nil {
+		return nil, err
+	}
+	if err := checkSize("cols", cols, 0); err != nil {
+		return nil, err
+	}
+	// rows*cols はオーバーフローしうるため、割り算で比べる。
+	if cols == 0 {
+		if len(slice) != 0 {
+			return nil, &SizeError{Name: "cols", Size: cols}
+		}
+	} else if len(slice)%cols != 0 {
+		return nil, &SizeError{Name: "cols", Size: cols}
+	} else if rows != len(slice)/cols {
+		return nil, &SizeError{Name: "rows", Size: rows}
+	}
+	return slices.Reshape(slice, rows, cols), nil
+}
+
+// 指定した行の要素を置き換える。
+func SetRow[T any](matrix [][]T, index int, row []T) error {
+	if err := checkIndex(index, len(matrix)); err != nil {
+		return err
+	}
+	slices.SetRow(matrix, index, row)
+	return nil
+}
+
+// 指定した位置からrows行cols列を切り出したスライスを返す。
+func SubMatrix[T any](matrix [][]T, row int, col int, rows int, cols int) ([][]T, error) {
+	if err := checkSize("rows", rows, 0); err != nil {
+		return nil, err
+	}
+	if err := checkSize("cols", cols, 0); err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return [][]T{}, nil
+	}
+	if err := checkIndex(row, len(matrix)); err != nil {
+		return nil, err
+	}
+	if err := checkIndex(row+rows-1, len(matrix)); err != nil {
+		return nil, err
+	}
+	for i := row; i < row+rows; i++ {
+		if err := checkIndex(col, len(matrix[i])+1); err != nil {
+			return nil, err
+		}
+		if err := checkIndex(col+cols, len(matrix[i])+1); err != nil {
+			return nil, err
+		}
+	}
+	dst, _ := slices.SubMatrix(matrix, row, col, rows, cols)
+	return dst, nil
+}
+
+// 指定した列の要素を置き換える。
+func SetColumn[T any](matrix [][]T, index int, column []T) error {
+	cols := 0
+	for i := range matrix {
+		if cols < len(matrix[i]) {
+			cols = len(matrix[i])
+		}
+	}
+	if err := checkIndex(index, cols); err != nil {
+		return err
+	}
+	slices.SetColumn(matrix, index, column)
+	return nil
+}
+
+// rows行cols列のグリッドを返す。
+func NewGrid[T any](rows int, cols int) (slices.Grid[T], error) {
+	if err := checkSize("rows", rows, 0); err != nil {
+		return slices.Grid[T]{}, err
+	}
+	if err := checkSize("cols", cols, 0); err != nil {
+		return slices.Grid[T]{}, err
+	}
+	if err := checkProduct("rows", rows, cols); err != nil {
+		return slices.Grid[T]{}, err
+	}
+	return slices.NewGrid[T](rows, cols), nil
+}
+
+// グリッドの指定した位置の要素を返す。
+func GridAt[T any](grid slices.Grid[T], row int, col int) (T, error) {
+	if err := checkGridIndex(grid, row, col); err != nil {
+		return *new(T), err
+	}
+	return grid.At(row, col), nil
+}
+
+// グリッドの指定した位置に値を代入する。
+func GridSet[T any](grid slices.Grid[T], row int, col int, v T) error {
+	if err := checkGridIndex(grid, row, col); err != nil {
+		return err
+	}
+	grid.Set(row, col, v)
+	return nil
+}
+
+// グリッドの指定した行を返す。
+// 返すスライスはグリッドと要素を共有する。
+func GridRow[T any](grid slices.Grid[T], row int) ([]T, error) {
+	if err := checkIndex(row, grid.Rows()); err != nil {
+		return nil, err
+	}
+	return grid.Row(row), nil
+}
+
+// グリッドの指定した列をコピーしたスライスを返す。
+func GridColumn[T any](grid slices.Grid[T], col int) ([]T, error) {
+	if err := checkIndex(col, grid.Cols()); err != nil {
+		return nil, err
+	}
+	return grid.Column(col), nil
+}
+
+func checkGridIndex[T any](grid slices.Grid[T], row int, col int) error {
+	if err := checkIndex(row, grid.Rows()); err != nil {
+		return err
+	}
+	return checkIndex(col, grid.Cols())
+}
+
+// n個のビットを持つビットセットを返す。
+func NewBitSet(n int) (slices.BitSet, error) {
+	if err := checkSize("n", n, 0); err != nil {
+		return slices.BitSet{}, err
+	}
+	return slices.NewBitSet(n), nil
+}
+
+// ビットセットの指定した位置のビットを立てる。
+func BitSetSet(b slices.BitSet, i int) error {
+	if err := checkIndex(i, b.Len()); err != nil {
+		return err
+	}
+	b.Set(i)
+	return nil
+}
+
+// ビットセットの指定した位置のビットを下ろす。
+func BitSetClear(b slices.BitSet, i int) error {
+	if err := checkIndex(i, b.Len()); err != nil {
+		return err
+	}
+	b.Clear(i)
+	return nil
+}
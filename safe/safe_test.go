@@ -0,0 +1,66 @@
+package safe
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/thamaji/slices"
+)
+
+func TestErrors(t *testing.T) {
+	if _, err := Get([]int{1}, -1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Get: got %v", err)
+	}
+	if _, err := Grouped([]int{1}, 0); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Grouped: got %v", err)
+	}
+	if _, err := Div([]int{1}, []int{0}); !errors.Is(err, ErrDivideByZero) {
+		t.Errorf("Div: got %v", err)
+	}
+	if _, err := Div([]int{1}, []int{}); !errors.Is(err, slices.ErrLengthMismatch) {
+		t.Errorf("Div: got %v", err)
+	}
+	if _, err := NewGrid[int](-1, 1); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("NewGrid: got %v", err)
+	}
+	if err := BitSetSet(slices.NewBitSet(3), 3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("BitSetSet: got %v", err)
+	}
+	if _, err := FromScannerChunked(nil, 0); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("FromScannerChunked: got %v", err)
+	}
+
+	if _, err := Reshape([]int{}, 1<<32, 1<<32); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Reshape: got %v", err)
+	}
+	if _, err := Reshape([]int{1, 2, 3, 4, 5, 6}, 2, 4); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Reshape: got %v", err)
+	}
+	if got, err := Reshape([]int{1, 2, 3, 4, 5, 6}, 2, 3); err != nil || len(got) != 2 {
+		t.Errorf("Reshape: got %v %v", got, err)
+	}
+	if _, err := NewGrid[int](1<<32, 1<<32); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("NewGrid: got %v", err)
+	}
+	if _, err := Cycle([]int{1, 2, 3}, math.MaxInt/2); !errors.Is(err, ErrInvalidSize) {
+		t.Errorf("Cycle: got %v", err)
+	}
+	if got, err := RemoveN([]int{1, 2, 3}, 1, math.MaxInt); err != nil || !slices.Equal(got, []int{1}) {
+		t.Errorf("RemoveN: got %v %v", got, err)
+	}
+	if slices.RunWith([]int{1}, func(int) {})(-1) {
+		t.Error("RunWith: got true for a negative index")
+	}
+
+	var e *IndexError
+	if _, err := GridAt(slices.NewGrid[int](2, 3), 1, 3); !errors.As(err, &e) || e.Index != 3 || e.Len != 3 {
+		t.Errorf("GridAt: got %v", err)
+	}
+	if err := SetColumn([][]int{{1, 2}, {3, 4, 5}}, 3, []int{0, 0}); !errors.As(err, &e) || e.Index != 3 || e.Len != 3 {
+		t.Errorf("SetColumn: got %v", err)
+	}
+	if slices.SetColumn([][]int{{1, 2}, {3, 4}}, 2, []int{0, 0}) {
+		t.Error("slices.SetColumn: got true for a missing column")
+	}
+}
@@ -0,0 +1,127 @@
+package slices
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// n番目に小さい要素がn番目に来るように並べ替える。
+// n番目より前にはそれ以下の要素が、後にはそれ以上の要素が並ぶ。
+func NthElement[T ordered](slice []T, n int) {
+	NthElementBy(slice, n, func(v1, v2 T) bool { return v1 < v2 })
+}
+
+// n番目に小さい要素がn番目に来るように並べ替える。
+// n番目より前にはそれ以下の要素が、後にはそれ以上の要素が並ぶ。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func NthElementBy[T any](slice []T, n int, less func(T, T) bool) {
+	if n < 0 || n >= len(slice) {
+		return
+	}
+	introSelect(slice, n, less, 2*bits.Len(uint(len(slice))))
+}
+
+// 小さい順にk個の要素をソートして先頭に並べる。残りの要素の順序は不定。
+func PartialSort[T ordered](slice []T, k int) {
+	PartialSortBy(slice, k, func(v1, v2 T) bool { return v1 < v2 })
+}
+
+// 小さい順にk個の要素をソートして先頭に並べる。残りの要素の順序は不定。
+// lessは v1 が v2 より前に並ぶ場合に true を返す関数。
+func PartialSortBy[T any](slice []T, k int, less func(T, T) bool) {
+	if k <= 0 {
+		return
+	}
+	if k > len(slice) {
+		k = len(slice)
+	}
+	NthElementBy(slice, k-1, less)
+	head := slice[:k]
+	sort.Slice(head, func(i, j int) bool { return less(head[i], head[j]) })
+}
+
+// クイックセレクトで選択する。再帰が深くなりすぎた場合は中央値の中央値に切り替えて線形時間を保証する。
+func introSelect[T any](slice []T, n int, less func(T, T) bool, limit int) {
+	for len(slice) > 12 {
+		var pivot int
+		if limit > 0 {
+			limit--
+			pivot = medianOfThree(slice, 0, len(slice)/2, len(slice)-1, less)
+		} else {
+			pivot = medianOfMedians(slice, less)
+		}
+		lt, gt := partition3(slice, pivot, less)
+		switch {
+		case n < lt:
+			slice = slice[:lt]
+		case n >= gt:
+			slice = slice[gt:]
+			n -= gt
+		default:
+			return
+		}
+	}
+	insertionSort(slice, less)
+}
+
+// 中央値の中央値を求めて、その位置を返す。
+func medianOfMedians[T any](slice []T, less func(T, T) bool) int {
+	if len(slice) <= 5 {
+		insertionSort(slice, less)
+		return len(slice) / 2
+	}
+	m := 0
+	for i := 0; i < len(slice); i += 5 {
+		end := i + 5
+		if end > len(slice) {
+			end = len(slice)
+		}
+		insertionSort(slice[i:end], less)
+		mid := i + (end-i)/2
+		slice[m], slice[mid] = slice[mid], slice[m]
+		m++
+	}
+	introSelect(slice[:m], m/2, less, 0)
+	return m / 2
+}
+
+// ピボットより小さい要素、等しい要素、大きい要素の順に並べ替え、等しい要素の範囲を返す。
+func partition3[T any](slice []T, pivot int, less func(T, T) bool) (int, int) {
+	p := slice[pivot]
+	lt, i, gt := 0, 0, len(slice)
+	for i < gt {
+		switch {
+		case less(slice[i], p):
+			slice[lt], slice[i] = slice[i], slice[lt]
+			lt++
+			i++
+		case less(p, slice[i]):
+			gt--
+			slice[gt], slice[i] = slice[i], slice[gt]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+func medianOfThree[T any](slice []T, a, b, c int, less func(T, T) bool) int {
+	if less(slice[b], slice[a]) {
+		a, b = b, a
+	}
+	if less(slice[c], slice[b]) {
+		b = c
+		if less(slice[b], slice[a]) {
+			b = a
+		}
+	}
+	return b
+}
+
+func insertionSort[T any](slice []T, less func(T, T) bool) {
+	for i := 1; i < len(slice); i++ {
+		for j := i; j > 0 && less(slice[j], slice[j-1]); j-- {
+			slice[j], slice[j-1] = slice[j-1], slice[j]
+		}
+	}
+}
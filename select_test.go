@@ -0,0 +1,93 @@
+package slices
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func randomInts(r *rand.Rand, n int, max int) []int {
+	slice := make([]int, n)
+	for i := range slice {
+		slice[i] = r.Intn(max)
+	}
+	return slice
+}
+
+func TestNthElement(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		slice := randomInts(r, r.Intn(200)+1, r.Intn(50)+1)
+		sorted := Clone(slice)
+		sort.Ints(sorted)
+		n := r.Intn(len(slice))
+
+		NthElement(slice, n)
+		if slice[n] != sorted[n] {
+			t.Fatalf("NthElement: got %d, want %d", slice[n], sorted[n])
+		}
+		for j := range slice {
+			if (j < n && slice[j] > slice[n]) || (j > n && slice[j] < slice[n]) {
+				t.Fatalf("NthElement: %v is not partitioned at %d", slice, n)
+			}
+		}
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 1000; i++ {
+		slice := randomInts(r, r.Intn(200)+1, r.Intn(50)+1)
+		sorted := Clone(slice)
+		sort.Ints(sorted)
+		k := r.Intn(len(slice) + 1)
+
+		PartialSort(slice, k)
+		if !Equal(slice[:k], sorted[:k]) {
+			t.Fatalf("PartialSort: got %v, want %v", slice[:k], sorted[:k])
+		}
+	}
+}
+
+func TestMedianOfMediansFallback(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	less := func(v1, v2 int) bool { return v1 < v2 }
+	for i := 0; i < 1000; i++ {
+		slice := randomInts(r, r.Intn(300)+1, r.Intn(40)+1)
+		sorted := Clone(slice)
+		sort.Ints(sorted)
+		n := r.Intn(len(slice))
+
+		// limit を0にして最初から中央値の中央値をピボットに使う。
+		introSelect(slice, n, less, 0)
+		if slice[n] != sorted[n] {
+			t.Fatalf("introSelect: got %d, want %d", slice[n], sorted[n])
+		}
+	}
+}
+
+const benchmarkSelectSize = 1000000
+
+func benchmarkSelect(b *testing.B, f func([]int)) {
+	src := randomInts(rand.New(rand.NewSource(4)), benchmarkSelectSize, benchmarkSelectSize)
+	slice := make([]int, len(src))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copy(slice, src)
+		b.StartTimer()
+		f(slice)
+	}
+}
+
+func BenchmarkSortInts(b *testing.B) {
+	benchmarkSelect(b, sort.Ints)
+}
+
+func BenchmarkNthElement(b *testing.B) {
+	benchmarkSelect(b, func(slice []int) { NthElement(slice, len(slice)/2) })
+}
+
+func BenchmarkPartialSort100(b *testing.B) {
+	benchmarkSelect(b, func(slice []int) { PartialSort(slice, 100) })
+}
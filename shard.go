@@ -0,0 +1,95 @@
+package slices
+
+import (
+	"hash/fnv"
+	"sort"
+)
+
+// スライスを要素数がほぼ等しいn個の連続した部分に分割したスライスを返す。
+func SplitN[T any](slice []T, n int) [][]T {
+	dst := make([][]T, n)
+	size, rest := len(slice)/n, len(slice)%n
+	start := 0
+	for i := range dst {
+		end := start + size
+		if i < rest {
+			end++
+		}
+		dst[i] = slice[start:end:end]
+		start = end
+	}
+	return dst
+}
+
+// 要素を先頭から順にn個のスライスに振り分ける。
+// 振り分けたスライスと、要素の位置ごとの振り分け先を返す。
+func Distribute[T any](slice []T, n int) ([][]T, []int) {
+	mapping := make([]int, len(slice))
+	for i := range mapping {
+		mapping[i] = i % n
+	}
+	return assign(slice, n, mapping), mapping
+}
+
+// 要素を関数の返すキーのハッシュでn個のスライスに振り分ける。
+// nが増減しても振り分け先の変わる要素が最小になるようにする。
+// 振り分けたスライスと、要素の位置ごとの振り分け先を返す。
+func ShardBy[T any](slice []T, n int, f func(T) string) ([][]T, []int) {
+	mapping := make([]int, len(slice))
+	h := fnv.New64a()
+	for i := range slice {
+		h.Reset()
+		h.Write([]byte(f(slice[i])))
+		mapping[i] = jumpHash(h.Sum64(), n)
+	}
+	return assign(slice, n, mapping), mapping
+}
+
+// 関数の返す重みの合計がなるべく均等になるように要素をn個のスライスに振り分ける。
+// 重いものから順に、その時点で合計が最も小さいスライスに振り分ける。
+// 振り分けたスライスと、要素の位置ごとの振り分け先を返す。
+func BalanceBy[T any, W number](slice []T, n int, f func(T) W) ([][]T, []int) {
+	weights := make([]W, len(slice))
+	order := make([]int, len(slice))
+	for i := range slice {
+		weights[i] = f(slice[i])
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return weights[order[i]] > weights[order[j]] })
+
+	totals := make([]W, n)
+	mapping := make([]int, len(slice))
+	for _, i := range order {
+		k := 0
+		for j := 1; j < n; j++ {
+			if totals[j] < totals[k] {
+				k = j
+			}
+		}
+		totals[k] += weights[i]
+		mapping[i] = k
+	}
+	return assign(slice, n, mapping), mapping
+}
+
+func assign[T any](slice []T, n int, mapping []int) [][]T {
+	dst := make([][]T, n)
+	for i := range dst {
+		dst[i] = []T{}
+	}
+	for i := range slice {
+		dst[mapping[i]] = append(dst[mapping[i]], slice[i])
+	}
+	return dst
+}
+
+// Jump Consistent Hash (Lamping, Veach)
+func jumpHash(key uint64, n int) int {
+	var b, j int64 = -1, 0
+	for j < int64(n) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
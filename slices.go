@@ -1,6 +1,7 @@
 package slices
 
 import (
+	"math"
 	"math/rand"
 
 	"github.com/thamaji/slices/tuple"
@@ -9,9 +10,7 @@ import (
 // 指定した値をn個複製したスライスを返す。
 func Repeat[T any](n int, v T) []T {
 	slice := make([]T, n)
-	for i := 0; i < n; i++ {
-		slice[i] = v
-	}
+	Fill(slice, v)
 	return slice
 }
 
@@ -26,7 +25,7 @@ func RepeatBy[T any](n int, f func() T) []T {
 
 // 指定した位置の要素を返す。
 func Get[T any](slice []T, index int) (T, bool) {
-	if index < len(slice) {
+	if index >= 0 && index < len(slice) {
 		return slice[index], true
 	}
 	return *new(T), false
@@ -34,7 +33,7 @@ func Get[T any](slice []T, index int) (T, bool) {
 
 // 指定した位置の要素を返す。無い場合はvを返す。
 func GetOrElse[T any](slice []T, index int, v T) T {
-	if index < len(slice) {
+	if index >= 0 && index < len(slice) {
 		return slice[index]
 	}
 	return v
@@ -76,7 +75,7 @@ func GetLastOrElse[T any](slice []T, v T) T {
 // 関数は、要素が範囲内なら true を、範囲外なら false を返す。
 func RunWith[T any](slice []T, f func(T)) func(int) bool {
 	return func(i int) bool {
-		if i >= len(slice) {
+		if i < 0 || i >= len(slice) {
 			return false
 		}
 		f(slice[i])
@@ -84,66 +83,80 @@ func RunWith[T any](slice []T, f func(T)) func(int) bool {
 	}
 }
 
-// 指定した位置に要素を追加する。
+// 指定した位置に要素を追加したスライスを返す。
+// 常に新しいスライスを返し、元のスライスは変更しない。
 func Insert[T any](slice []T, index int, v ...T) []T {
-	return append(slice[:index], append(v, slice[index:]...)...)
+	dst := make([]T, 0, len(slice)+len(v))
+	dst = append(dst, slice[:index]...)
+	dst = append(dst, v...)
+	return append(dst, slice[index:]...)
 }
 
 // 末尾に要素を追加する。
+// append と同じく、容量に余裕があれば元のスライスの後ろの領域に書き込む。
 func Push[T any](slice []T, v ...T) []T {
 	return append(slice, v...)
 }
 
-// 先頭に要素を追加する。
+// 先頭に要素を追加したスライスを返す。
+// 常に新しいスライスを返し、元のスライスは変更しない。
 func PushBack[T any](slice []T, v ...T) []T {
-	return append(v, slice...)
+	dst := make([]T, 0, len(v)+len(slice))
+	dst = append(dst, v...)
+	return append(dst, slice...)
 }
 
 // 末尾から要素を取り出す。
+// 返すスライスは元のスライスと要素を共有する。
 func Pop[T any](slice []T) (T, []T) {
 	if len(slice) == 0 {
-		return *new(T), slice
+		return *new(T), Clip(slice)
 	}
-	return slice[len(slice)-1], slice[:len(slice)-1]
+	return slice[len(slice)-1], Clip(slice[:len(slice)-1])
 }
 
 // 末尾からn個の要素を取り出す。
+// 返すスライスは元のスライスと要素を共有する。
 func PopN[T any](slice []T, n int) ([]T, []T) {
 	if n > len(slice) {
 		n = len(slice)
 	}
-	return slice[len(slice)-n:], slice[:len(slice)-n]
+	return Clip(slice[len(slice)-n:]), Clip(slice[:len(slice)-n])
 }
 
 // 先頭から要素を取り出す。
+// 返すスライスは元のスライスと要素を共有する。
 func PopBack[T any](slice []T) (T, []T) {
 	if len(slice) == 0 {
-		return *new(T), slice
+		return *new(T), Clip(slice)
 	}
-	return slice[0], slice[1:]
+	return slice[0], Clip(slice[1:])
 }
 
 // 先頭からn個の要素を取り出す。
+// 返すスライスは元のスライスと要素を共有する。
 func PopBackN[T any](slice []T, n int) ([]T, []T) {
 	if n > len(slice) {
 		n = len(slice)
 	}
-	return slice[:n], slice[n:]
+	return Clip(slice[:n]), Clip(slice[n:])
 }
 
 // 指定した位置の要素を削除する。
+// 元のスライスの要素を前に詰めて書き換える。
 func Remove[T any](slice []T, index int) []T {
 	return RemoveN(slice, index, 1)
 }
 
 // 指定した位置からn個の要素を削除する。
+// 元のスライスの要素を前に詰めて書き換える。
 func RemoveN[T any](slice []T, index int, n int) []T {
-	if index+n > len(slice) {
-		n = len(slice) - index
-	}
 	if index > len(slice) {
 		return slice
 	}
+	if n > len(slice)-index {
+		n = len(slice) - index
+	}
 	copy(slice[index:], slice[index+n:])
 	for i := len(slice) - n; i < len(slice); i++ {
 		slice[i] = *new(T)
@@ -159,6 +172,31 @@ func Clear[T any](slice []T) []T {
 	return slice[:0]
 }
 
+// 容量を要素数に切り詰めたスライスを返す。
+// 返すスライスに要素を追加すると新しい領域が確保されるため、元のスライスを壊さない。
+func Clip[T any](slice []T) []T {
+	return slice[:len(slice):len(slice)]
+}
+
+// 少なくともn個の要素を追加できる容量を持つスライスを返す。
+func Grow[T any](slice []T, n int) []T {
+	if n <= cap(slice)-len(slice) {
+		return slice
+	}
+	dst := make([]T, len(slice), len(slice)+n)
+	copy(dst, slice)
+	return dst
+}
+
+// 使われていない容量が半分を超えていたら、容量を要素数に合わせたスライスを返す。
+// そうでなければ元のスライスを返す。
+func ShrinkToFit[T any](slice []T) []T {
+	if cap(slice)-len(slice) <= cap(slice)/2 {
+		return slice
+	}
+	return Clone(slice)
+}
+
 // 要素をすべてコピーしたスライスを返す。
 func Clone[T any](slice []T) []T {
 	clone := make([]T, len(slice))
@@ -215,7 +253,7 @@ func Grouped[T any](slice []T, n int) [][]T {
 	if len(slice) == 0 {
 		return [][]T{}
 	}
-	grouped := make([][]T, len(slice)/n)
+	grouped := make([][]T, (len(slice)+n-1)/n)
 	for i := range slice {
 		grouped[i/n] = append(grouped[i/n], slice[i])
 	}
@@ -291,6 +329,9 @@ OUTER:
 
 // 値と一致する要素の数を返す。
 func Count[T comparable](slice []T, v T) int {
+	if len(slice) >= fastPathMinLen {
+		return countFast(slice, v)
+	}
 	c := 0
 	for i := range slice {
 		if slice[i] == v {
@@ -313,6 +354,9 @@ func CountBy[T any](slice []T, f func(T) bool) int {
 
 // 値と一致する最初の要素の位置を返す。
 func Index[T comparable](slice []T, v T) int {
+	if len(slice) >= fastPathMinLen {
+		return indexFast(slice, v)
+	}
 	for i := range slice {
 		if slice[i] == v {
 			return i
@@ -369,11 +413,255 @@ func ReverseInplace[T any](slice []T) []T {
 	return slice
 }
 
-// 連番のスライスを返す。
-func Range[T ordered](start T, stop T, step T) []T {
+// 要素を左にn個ずらす。先頭からあふれた要素は終端に移る。
+func RotateLeft[T any](slice []T, n int) []T {
+	if len(slice) == 0 {
+		return slice
+	}
+	n %= len(slice)
+	if n < 0 {
+		n += len(slice)
+	}
+	ReverseInplace(slice[:n])
+	ReverseInplace(slice[n:])
+	return ReverseInplace(slice)
+}
+
+// 要素を右にn個ずらす。終端からあふれた要素は先頭に移る。
+func RotateRight[T any](slice []T, n int) []T {
+	if len(slice) == 0 {
+		return slice
+	}
+	return RotateLeft(slice, len(slice)-n%len(slice))
+}
+
+// 指定した位置の要素を入れ替える。
+func Swap[T any](slice []T, i int, j int) {
+	slice[i], slice[j] = slice[j], slice[i]
+}
+
+// 指定した位置の要素を移動する。他の要素の順序は保たれる。
+func Move[T any](slice []T, from int, to int) []T {
+	if from < to {
+		RotateLeft(slice[from:to+1], 1)
+	} else if from > to {
+		RotateRight(slice[to:from+1], 1)
+	}
+	return slice
+}
+
+// 複数のスライスの要素を先頭から交互に並べたスライスを返す。
+// スライスの要素数が異なる場合、最も小さいものに合わせる。
+func Interleave[T any](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return []T{}
+	}
+	size := len(slices[0])
+	for i := 1; i < len(slices); i++ {
+		if size > len(slices[i]) {
+			size = len(slices[i])
+		}
+	}
+	dst := make([]T, 0, size*len(slices))
+	for i := 0; i < size; i++ {
+		for j := range slices {
+			dst = append(dst, slices[j][i])
+		}
+	}
+	return dst
+}
+
+// 複数のスライスの要素を先頭から交互に並べたスライスを返す。
+// 要素を使い切ったスライスは飛ばし、すべての要素を含める。
+func RoundRobin[T any](slices ...[]T) []T {
+	size, max := 0, 0
+	for i := range slices {
+		size += len(slices[i])
+		if max < len(slices[i]) {
+			max = len(slices[i])
+		}
+	}
+	dst := make([]T, 0, size)
+	for i := 0; i < max; i++ {
+		for j := range slices {
+			if i < len(slices[j]) {
+				dst = append(dst, slices[j][i])
+			}
+		}
+	}
+	return dst
+}
+
+// 要素の間に区切り要素を挟んだスライスを返す。
+func Intersperse[T any](slice []T, separator T) []T {
+	return Join(separator, slice...)
+}
+
+// スライスをn回繰り返したスライスを返す。
+func Cycle[T any](slice []T, n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	dst := make([]T, 0, len(slice)*n)
+	for i := 0; i < n; i++ {
+		dst = append(dst, slice...)
+	}
+	return dst
+}
+
+// startからstopの手前までstepずつ増減する連番のスライスを返す。
+// stepが0の場合は ErrZeroStep を返す。符号なし整数は減らしていくことができない。
+// 浮動小数点数で要素数が求まらないか大きすぎる場合は ErrInvalidRange を返す。
+func Range[T number](start T, stop T, step T) ([]T, error) {
+	if step == 0 {
+		return nil, ErrZeroStep
+	}
+	if isFloat[T]() {
+		return floatRange(start, stop, step, false)
+	}
+	next, err := RangeIter(start, stop, step)
+	if err != nil {
+		return nil, err
+	}
+	slice := []T{}
+	for v, ok := next(); ok; v, ok = next() {
+		slice = append(slice, v)
+	}
+	return slice, nil
+}
+
+// startからstopまでstepずつ増減する連番のスライスを返す。
+// stepが0の場合は ErrZeroStep を返す。符号なし整数は減らしていくことができない。
+// 浮動小数点数の場合は誤差を許容して要素数を求め、終端をstopに揃える。
+// 浮動小数点数で要素数が求まらないか大きすぎる場合は ErrInvalidRange を返す。
+func RangeInclusive[T number](start T, stop T, step T) ([]T, error) {
+	if step == 0 {
+		return nil, ErrZeroStep
+	}
+	if isFloat[T]() {
+		return floatRange(start, stop, step, true)
+	}
 	slice := []T{}
-	for i := start; i < stop; i += step {
-		slice = append(slice, i)
+	for i := 0; ; i++ {
+		v := start + T(i)*step
+		if (step > 0 && v > stop) || (step < 0 && v < stop) {
+			break
+		}
+		// 整数のオーバーフローで値が巻き戻ったら終了する。
+		if i > 0 && ((step > 0 && v <= slice[i-1]) || (step < 0 && v >= slice[i-1])) {
+			break
+		}
+		slice = append(slice, v)
+	}
+	return slice, nil
+}
+
+// startからstopの手前までstepずつ増減する連番を順に返す関数を返す。
+// 関数は、値があれば true を、終端に達したら false を返す。
+// stepが0の場合は ErrZeroStep を返す。符号なし整数は減らしていくことができない。
+// 浮動小数点数で要素数が求まらない場合は ErrInvalidRange を返す。
+func RangeIter[T number](start T, stop T, step T) (func() (T, bool), error) {
+	if step == 0 {
+		return nil, ErrZeroStep
+	}
+	if isFloat[T]() {
+		n := floatRangeLen(start, stop, step, false)
+		if math.IsNaN(n) {
+			return nil, ErrInvalidRange
+		}
+		// 要素数が int に収まらなくても扱えるよう、位置も浮動小数点数で数える。
+		i := 0.0
+		return func() (T, bool) {
+			if i >= n {
+				return *new(T), false
+			}
+			v := start + T(i)*step
+			i++
+			return v, true
+		}, nil
+	}
+	i := 0
+	prev := start
+	done := false
+	return func() (T, bool) {
+		if done {
+			return *new(T), false
+		}
+		v := start + T(i)*step
+		if (step > 0 && v >= stop) || (step < 0 && v <= stop) {
+			done = true
+			return *new(T), false
+		}
+		// 整数のオーバーフローで値が巻き戻ったら終了する。
+		if i > 0 && ((step > 0 && v <= prev) || (step < 0 && v >= prev)) {
+			done = true
+			return *new(T), false
+		}
+		i++
+		prev = v
+		return v, true
+	}, nil
+}
+
+// 浮動小数点数の連番で、stopとの差をstepの何倍まで誤差とみなすか。
+const rangeTolerance = 1e-9
+
+// 浮動小数点数の連番の要素数を返す。
+// 要素数が求まらない場合は NaN を、終わりがない場合は +Inf を返す。
+func floatRangeLen[T number](start T, stop T, step T, inclusive bool) float64 {
+	q := float64(stop-start) / float64(step)
+	if q < 0 {
+		return 0
+	}
+	if inclusive {
+		return math.Floor(q+rangeTolerance) + 1
+	}
+	return math.Ceil(q - rangeTolerance)
+}
+
+// 浮動小数点数の連番のスライスを返す。
+func floatRange[T number](start T, stop T, step T, inclusive bool) ([]T, error) {
+	n := floatRangeLen(start, stop, step, inclusive)
+	if math.IsNaN(n) || n >= float64(math.MaxInt) {
+		return nil, ErrInvalidRange
+	}
+	slice := make([]T, int(n))
+	for i := range slice {
+		slice[i] = start + T(i)*step
+	}
+	if inclusive && len(slice) > 0 && math.Abs(float64(slice[len(slice)-1]-stop)) <= rangeTolerance*math.Abs(float64(step)) {
+		slice[len(slice)-1] = stop
+	}
+	return slice, nil
+}
+
+func isFloat[T number]() bool {
+	var v T = 1
+	return v/2 != 0
+}
+
+// startからstopまでをn等分した値のスライスを返す。
+func Linspace[T float](start T, stop T, n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	if n == 1 {
+		return []T{start}
+	}
+	slice := make([]T, n)
+	d := stop - start
+	for i := 0; i < n-1; i++ {
+		slice[i] = start + d*T(i)/T(n-1)
+	}
+	slice[n-1] = stop
+	return slice
+}
+
+// base^startからbase^stopまでを対数スケールでn等分した値のスライスを返す。
+func Logspace[T float](start T, stop T, n int, base T) []T {
+	slice := Linspace(start, stop, n)
+	for i := range slice {
+		slice[i] = T(math.Pow(float64(base), float64(slice[i])))
 	}
 	return slice
 }
@@ -500,6 +788,79 @@ func ScanRight[T1, T2 any](slice []T1, v T2, f func(T2, T1) T2) []T2 {
 	return dst
 }
 
+// 要素を先頭から順に演算する。関数が false を返したら終了する。
+// 演算結果と、演算に使った要素の数を返す。false を返した要素は数に含めず、その結果も捨てる。
+func ReduceWhile[T any](slice []T, f func(T, T) (T, bool)) (T, int) {
+	if len(slice) == 0 {
+		return *new(T), 0
+	}
+
+	v := slice[0]
+	for i := 1; i < len(slice); i++ {
+		next, ok := f(v, slice[i])
+		if !ok {
+			return v, i
+		}
+		v = next
+	}
+	return v, len(slice)
+}
+
+// 初期値と要素を先頭から順に演算する。関数が false を返したら終了する。
+// 演算結果と、演算に使った要素の数を返す。false を返した要素は数に含めず、その結果も捨てる。
+func FoldWhile[T1, T2 any](slice []T1, v T2, f func(T2, T1) (T2, bool)) (T2, int) {
+	for i := range slice {
+		next, ok := f(v, slice[i])
+		if !ok {
+			return v, i
+		}
+		v = next
+	}
+	return v, len(slice)
+}
+
+// 初期値と要素を終端から順に演算する。関数が false を返したら終了する。
+// 演算結果と、終端から数えた演算に使った要素の数を返す。false を返した要素は数に含めず、その結果も捨てる。
+func FoldRightWhile[T1, T2 any](slice []T1, v T2, f func(T2, T1) (T2, bool)) (T2, int) {
+	for i := len(slice) - 1; i >= 0; i-- {
+		next, ok := f(v, slice[i])
+		if !ok {
+			return v, len(slice) - i - 1
+		}
+		v = next
+	}
+	return v, len(slice)
+}
+
+// 初期値と要素を先頭から順に演算して途中経過のスライスを返す。関数が false を返したら終了する。
+// 途中経過のスライスと、演算に使った要素の数を返す。false を返した要素は数に含めず、その結果も捨てる。
+func ScanWhile[T1, T2 any](slice []T1, v T2, f func(T2, T1) (T2, bool)) ([]T2, int) {
+	dst := make([]T2, 0, len(slice)+1)
+	dst = append(dst, v)
+	for i := range slice {
+		next, ok := f(v, slice[i])
+		if !ok {
+			return dst, i
+		}
+		v = next
+		dst = append(dst, v)
+	}
+	return dst, len(slice)
+}
+
+// 初期値と要素を先頭から順に演算する。関数がエラーを返したら終了する。
+// 演算結果と、演算に使った要素の数とエラーを返す。エラーを返した要素は数に含めず、その結果も捨てる。
+func TryFold[T1, T2 any](slice []T1, v T2, f func(T2, T1) (T2, error)) (T2, int, error) {
+	for i := range slice {
+		next, err := f(v, slice[i])
+		if err != nil {
+			return v, i, err
+		}
+		v = next
+	}
+	return v, len(slice), nil
+}
+
 // スライスを平坦化する。
 func Flatten[T any](slice [][]T) []T {
 	dst := make([]T, 0, len(slice))
@@ -589,69 +950,76 @@ func FindBy[T any](slice []T, f func(T) bool) (ret T, ok bool) {
 }
 
 // 値と一致する先頭部分と一致しない残りの部分を返す。
+// 返すスライスは元のスライスと要素を共有する。
 func Span[T comparable](slice []T, v T) ([]T, []T) {
 	for i := range slice {
 		if slice[i] != v {
-			return slice[0:i], slice[i:]
+			return Clip(slice[0:i]), Clip(slice[i:])
 		}
 	}
-	return slice, []T{}
+	return Clip(slice), []T{}
 }
 
 // 条件を満たす先頭部分と満たさない残りの部分を返す。
+// 返すスライスは元のスライスと要素を共有する。
 func SpanBy[T any](slice []T, f func(T) bool) ([]T, []T) {
 	for i := range slice {
 		if !f(slice[i]) {
-			return slice[0:i], slice[i:]
+			return Clip(slice[0:i]), Clip(slice[i:])
 		}
 	}
-	return slice, []T{}
+	return Clip(slice), []T{}
 }
 
 // 先頭n個の要素を返す。
+// 返すスライスは元のスライスと要素を共有する。
 func Take[T any](slice []T, n int) []T {
 	if n > len(slice) {
-		return slice
+		return Clip(slice)
 	}
-	return slice[:n]
+	return Clip(slice[:n])
 }
 
 // 値と一致する先頭のスライスを返す。
 // 値と一致しなかった時点で終了する。
+// 返すスライスは元のスライスと要素を共有する。
 func TakeWhile[T comparable](slice []T, v T) []T {
 	for i := range slice {
 		if slice[i] != v {
-			return slice[0:i]
+			return Clip(slice[0:i])
 		}
 	}
-	return slice
+	return Clip(slice)
 }
 
 // 条件を満たす先頭のスライスを返す。
 // 条件を満たさなかった時点で終了する。
+// 返すスライスは元のスライスと要素を共有する。
 func TakeWhileBy[T any](slice []T, f func(T) bool) []T {
 	for i := range slice {
 		if !f(slice[i]) {
-			return slice[0:i]
+			return Clip(slice[0:i])
 		}
 	}
-	return slice
+	return Clip(slice)
 }
 
 // 先頭n個の要素を除いたスライスを返す。
+// 返すスライスは元のスライスと要素を共有する。
 func Drop[T any](slice []T, n int) []T {
 	if n > len(slice) {
 		return []T{}
 	}
-	return slice[n:]
+	return Clip(slice[n:])
 }
 
 // 値と一致する先頭の要素を除いていったスライスを返す。
 // 値と一致しなかった時点で終了する。
+// 返すスライスは元のスライスと要素を共有する。
 func DropWhile[T comparable](slice []T, v T) []T {
 	for i := range slice {
 		if slice[i] != v {
-			return slice[i:]
+			return Clip(slice[i:])
 		}
 	}
 	return []T{}
@@ -659,10 +1027,11 @@ func DropWhile[T comparable](slice []T, v T) []T {
 
 // 条件を満たす先頭の要素を除いていったスライスを返す。
 // 条件を満たさなかった時点で終了する。
+// 返すスライスは元のスライスと要素を共有する。
 func DropWhileBy[T any](slice []T, f func(T) bool) []T {
 	for i := range slice {
 		if !f(slice[i]) {
-			return slice[i:]
+			return Clip(slice[i:])
 		}
 	}
 	return []T{}
@@ -673,6 +1042,9 @@ func Equal[T comparable](slices1 []T, slices2 []T) bool {
 	if len(slices1) != len(slices2) {
 		return false
 	}
+	if len(slices1) >= fastPathMinLen {
+		return equalFast(slices1, slices2)
+	}
 	for i := 0; i < len(slices1); i++ {
 		if slices1[i] != slices2[i] {
 			return false
@@ -694,6 +1066,66 @@ func EqualBy[T any](slices1 []T, slices2 []T, f func(T, T) bool) bool {
 	return true
 }
 
+// 順序を問わずに要素とその個数が一致していたらtrue。
+func EqualUnordered[T comparable](slices1 []T, slices2 []T) bool {
+	return EqualUnorderedBy(slices1, slices2, func(v T) T { return v })
+}
+
+// 順序を問わずに関数の返すキーとその個数が一致していたらtrue。
+func EqualUnorderedBy[T any, K comparable](slices1 []T, slices2 []T, f func(T) K) bool {
+	if len(slices1) != len(slices2) {
+		return false
+	}
+	counts := make(map[K]int, len(slices1))
+	for i := range slices1 {
+		counts[f(slices1[i])]++
+	}
+	for i := range slices2 {
+		k := f(slices2[i])
+		if counts[k] == 0 {
+			return false
+		}
+		counts[k]--
+	}
+	return true
+}
+
+// スライスを辞書順で比較する。
+// slices1が小さければ-1、等しければ0、大きければ1を返す。
+func Compare[T ordered](slices1 []T, slices2 []T) int {
+	return CompareBy(slices1, slices2, Natural[T]())
+}
+
+// 要素を比較する関数を使ってスライスを辞書順で比較する。
+// slices1が小さければ-1、等しければ0、大きければ1を返す。
+func CompareBy[T any](slices1 []T, slices2 []T, f func(T, T) int) int {
+	for i := 0; i < len(slices1) && i < len(slices2); i++ {
+		if c := f(slices1[i], slices2[i]); c != 0 {
+			if c < 0 {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(slices1) < len(slices2):
+		return -1
+	case len(slices1) > len(slices2):
+		return 1
+	}
+	return 0
+}
+
+// 辞書順でslices1がslices2より小さければtrue。
+func Less[T ordered](slices1 []T, slices2 []T) bool {
+	return Compare(slices1, slices2) < 0
+}
+
+// 要素を比較する関数を使って辞書順でslices1がslices2より小さければtrue。
+func LessBy[T any](slices1 []T, slices2 []T, f func(T, T) int) bool {
+	return CompareBy(slices1, slices2, f) < 0
+}
+
 // スライスの先頭がスライスと一致していたら true を返す。
 func StartWith[T comparable](slice1 []T, slice2 []T) bool {
 	if len(slice1) < len(slice2) {
@@ -807,37 +1239,116 @@ func UniqueBy[T any](slice []T, f func(T, T) bool) []T {
 }
 
 // 重複を排除したスライスを返す。
+// 最初に現れた要素を残し、順序を保つ。
+// 元のスライスの要素を前に詰めて書き換え、返すスライスは元のスライスと要素を共有する。
 func UniqueInplace[T comparable](slice []T) []T {
-	size := len(slice)
-	var j int
-	for i := 0; i < size; i++ {
-		for j = i + 1; j < size; {
-			if slice[i] == slice[j] {
-				size--
-				slice[j], slice[size] = slice[size], slice[j]
-			} else {
-				j++
-			}
+	seen := make(map[T]struct{}, len(slice))
+	c := 0
+	for i := range slice {
+		if _, ok := seen[slice[i]]; ok {
+			continue
 		}
+		seen[slice[i]] = struct{}{}
+		slice[c] = slice[i]
+		c++
 	}
-	return slice[:size]
+	for i := c; i < len(slice); i++ {
+		slice[i] = *new(T)
+	}
+	return slice[:c]
 }
 
 // 重複を排除したスライスを返す。
+// 最初に現れた要素を残し、順序を保つ。
+// 元のスライスの要素を前に詰めて書き換え、返すスライスは元のスライスと要素を共有する。
 func UniqueByInplace[T any](slice []T, f func(T, T) bool) []T {
-	size := len(slice)
+	c := 0
 	var j int
-	for i := 0; i < size; i++ {
-		for j = i + 1; j < size; {
-			if f(slice[i], slice[j]) {
-				size--
-				slice[j], slice[size] = slice[size], slice[j]
-			} else {
-				j++
+	for i := range slice {
+		for j = 0; j < c; j++ {
+			if f(slice[j], slice[i]) {
+				break
 			}
 		}
+		if j == c {
+			slice[c] = slice[i]
+			c++
+		}
+	}
+	for i := c; i < len(slice); i++ {
+		slice[i] = *new(T)
+	}
+	return slice[:c]
+}
+
+// 重複を排除したスライスを返す。
+// 最初に現れた要素を残し、順序を保つ。
+func Distinct[T comparable](slice []T) []T {
+	return DistinctBy(slice, func(v T) T { return v })
+}
+
+// 関数の返すキーで重複を排除したスライスを返す。
+// 最初に現れた要素を残し、順序を保つ。
+func DistinctBy[T any, K comparable](slice []T, f func(T) K) []T {
+	seen := make(map[K]struct{}, len(slice))
+	dst := make([]T, 0, len(slice))
+	for i := range slice {
+		k := f(slice[i])
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		dst = append(dst, slice[i])
+	}
+	return dst
+}
+
+// 重複を排除したスライスを返す。
+// 最後に現れた要素を残し、順序を保つ。
+func DistinctLast[T comparable](slice []T) []T {
+	return DistinctLastBy(slice, func(v T) T { return v })
+}
+
+// 関数の返すキーで重複を排除したスライスを返す。
+// 最後に現れた要素を残し、順序を保つ。
+func DistinctLastBy[T any, K comparable](slice []T, f func(T) K) []T {
+	seen := make(map[K]struct{}, len(slice))
+	dst := make([]T, 0, len(slice))
+	for i := len(slice) - 1; i >= 0; i-- {
+		k := f(slice[i])
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		dst = append(dst, slice[i])
+	}
+	return ReverseInplace(dst)
+}
+
+// 2回以上現れる要素のスライスを返す。
+// 要素は2回目に現れた順に並ぶ。
+func Duplicates[T comparable](slice []T) []T {
+	counts := make(map[T]int, len(slice))
+	dst := []T{}
+	for i := range slice {
+		counts[slice[i]]++
+		if counts[slice[i]] == 2 {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+// 重複する要素が存在したらtrue。
+func HasDuplicates[T comparable](slice []T) bool {
+	seen := make(map[T]struct{}, len(slice))
+	for i := range slice {
+		if _, ok := seen[slice[i]]; ok {
+			return true
+		}
+		seen[slice[i]] = struct{}{}
 	}
-	return slice[:size]
+	return false
 }
 
 // 値の一致する要素だけのスライスを返す。
@@ -863,11 +1374,13 @@ func FilterBy[T any](slice []T, f func(T) bool) []T {
 }
 
 // 値の一致する要素だけのスライスを返す。
+// 残した要素の順序を保つ。
+// 元のスライスの要素を前に詰めて書き換え、返すスライスは元のスライスと要素を共有する。
 func FilterInplace[T comparable](slice []T, v T) []T {
 	c := 0
 	for i := range slice {
 		if slice[i] == v {
-			slice[c], slice[i] = slice[i], slice[c]
+			slice[c] = slice[i]
 			c++
 		}
 	}
@@ -878,11 +1391,13 @@ func FilterInplace[T comparable](slice []T, v T) []T {
 }
 
 // 条件を満たす要素だけのスライスを返す。
+// 残した要素の順序を保つ。
+// 元のスライスの要素を前に詰めて書き換え、返すスライスは元のスライスと要素を共有する。
 func FilterByInplace[T any](slice []T, f func(T) bool) []T {
 	c := 0
 	for i := range slice {
 		if f(slice[i]) {
-			slice[c], slice[i] = slice[i], slice[c]
+			slice[c] = slice[i]
 			c++
 		}
 	}
@@ -915,11 +1430,13 @@ func FilterNotBy[T any](slice []T, f func(T) bool) []T {
 }
 
 // 値の一致しない要素だけのスライスを返す。
+// 残した要素の順序を保つ。
+// 元のスライスの要素を前に詰めて書き換え、返すスライスは元のスライスと要素を共有する。
 func FilterNotInplace[T comparable](slice []T, v T) []T {
 	c := 0
 	for i := range slice {
 		if slice[i] != v {
-			slice[c], slice[i] = slice[i], slice[c]
+			slice[c] = slice[i]
 			c++
 		}
 	}
@@ -930,11 +1447,13 @@ func FilterNotInplace[T comparable](slice []T, v T) []T {
 }
 
 // 条件を満たさない要素だけのスライスを返す。
+// 残した要素の順序を保つ。
+// 元のスライスの要素を前に詰めて書き換え、返すスライスは元のスライスと要素を共有する。
 func FilterNotByInplace[T any](slice []T, f func(T) bool) []T {
 	c := 0
 	for i := range slice {
 		if !f(slice[i]) {
-			slice[c], slice[i] = slice[i], slice[c]
+			slice[c] = slice[i]
 			c++
 		}
 	}
@@ -999,27 +1518,49 @@ func PartitionBy[T any](slice []T, f func(T) bool) ([]T, []T) {
 }
 
 // 値の一致するスライスと一致しないスライスを返す。
+// どちらのスライスも要素の順序を保つ。
+// 返すスライスは元のスライスと要素を共有する。
 func PartitionInplace[T comparable](slice []T, v T) ([]T, []T) {
-	c := 0
-	for i := range slice {
-		if slice[i] == v {
-			slice[c], slice[i] = slice[i], slice[c]
-			c++
-		}
-	}
-	return slice[:c], slice[c:]
+	return StablePartitionInplace(slice, v)
 }
 
 // 条件を満たすスライスと満たさないスライスを返す。
+// どちらのスライスも要素の順序を保つ。
+// 返すスライスは元のスライスと要素を共有する。
 func PartitionByInplace[T any](slice []T, f func(T) bool) ([]T, []T) {
-	c := 0
-	for i := range slice {
-		if f(slice[i]) {
-			slice[c], slice[i] = slice[i], slice[c]
-			c++
+	return StablePartitionByInplace(slice, f)
+}
+
+// 値の一致するスライスと一致しないスライスを返す。
+// どちらのスライスも要素の順序を保つ。PartitionInplace と同じ。
+// 返すスライスは元のスライスと要素を共有する。
+func StablePartitionInplace[T comparable](slice []T, v T) ([]T, []T) {
+	return StablePartitionByInplace(slice, func(t T) bool { return t == v })
+}
+
+// 条件を満たすスライスと満たさないスライスを返す。
+// どちらのスライスも要素の順序を保つ。PartitionByInplace と同じ。
+// 返すスライスは元のスライスと要素を共有する。
+func StablePartitionByInplace[T any](slice []T, f func(T) bool) ([]T, []T) {
+	c := stablePartition(slice, f)
+	return Clip(slice[:c]), Clip(slice[c:])
+}
+
+func stablePartition[T any](slice []T, f func(T) bool) int {
+	if len(slice) == 0 {
+		return 0
+	}
+	if len(slice) == 1 {
+		if f(slice[0]) {
+			return 1
 		}
+		return 0
 	}
-	return slice[:c], slice[c:]
+	mid := len(slice) / 2
+	c1 := stablePartition(slice[:mid], f)
+	c2 := stablePartition(slice[mid:], f)
+	RotateLeft(slice[c1:mid+c2], mid-c1)
+	return c1 + c2
 }
 
 // 要素の合計を返す。
@@ -1138,8 +1679,13 @@ func MinBy[T1 any, T2 ordered](slice []T1, f func(T1) T2) T2 {
 
 // すべての要素に値を代入する。
 func Fill[T any](slice []T, v T) {
-	for i := range slice {
-		slice[i] = v
+	if len(slice) == 0 {
+		return
+	}
+	// 書き込み済みの範囲を倍々にコピーする。
+	slice[0] = v
+	for n := 1; n < len(slice); n *= 2 {
+		copy(slice[n:], slice[:n])
 	}
 }
 
@@ -1158,9 +1704,10 @@ func FillBy[T any](slice []T, f func(int) T) {
 }
 
 // 要素がn個になるまで先頭にvを挿入する。
+// 要素数がn個以上の場合は、元のスライスと要素を共有するスライスを返す。
 func Pad[T any](slice []T, n int, v T) []T {
 	if len(slice) >= n {
-		return slice
+		return Clip(slice)
 	}
 	c := n - len(slice)
 	t := make([]T, c)
@@ -1171,9 +1718,10 @@ func Pad[T any](slice []T, n int, v T) []T {
 }
 
 // 要素がn個になるまで先頭にゼロ値を挿入する。
+// 要素数がn個以上の場合は、元のスライスと要素を共有するスライスを返す。
 func PadZero[T any](slice []T, n int) []T {
 	if len(slice) >= n {
-		return slice
+		return Clip(slice)
 	}
 	c := n - len(slice)
 	t := make([]T, c)
@@ -1184,9 +1732,10 @@ func PadZero[T any](slice []T, n int) []T {
 }
 
 // 要素がn個になるまで先頭に関数の実行結果を挿入する。
+// 要素数がn個以上の場合は、元のスライスと要素を共有するスライスを返す。
 func PadBy[T any](slice []T, n int, f func(int) T) []T {
 	if len(slice) >= n {
-		return slice
+		return Clip(slice)
 	}
 	c := n - len(slice)
 	t := make([]T, c)
@@ -1197,9 +1746,11 @@ func PadBy[T any](slice []T, n int, f func(int) T) []T {
 }
 
 // 要素がn個になるまで末尾にvを挿入する。
+// 要素数がn個以上の場合は、元のスライスと要素を共有するスライスを返す。
+// append と同じく、容量に余裕があれば元のスライスの後ろの領域に書き込む。
 func PadRight[T any](slice []T, n int, v T) []T {
 	if len(slice) >= n {
-		return slice
+		return Clip(slice)
 	}
 	c := n - len(slice)
 	t := make([]T, c)
@@ -1210,9 +1761,11 @@ func PadRight[T any](slice []T, n int, v T) []T {
 }
 
 // 要素がn個になるまで末尾にゼロ値を挿入する。
+// 要素数がn個以上の場合は、元のスライスと要素を共有するスライスを返す。
+// append と同じく、容量に余裕があれば元のスライスの後ろの領域に書き込む。
 func PadZeroRight[T any](slice []T, n int) []T {
 	if len(slice) >= n {
-		return slice
+		return Clip(slice)
 	}
 	c := n - len(slice)
 	t := make([]T, c)
@@ -1223,9 +1776,11 @@ func PadZeroRight[T any](slice []T, n int) []T {
 }
 
 // 要素がn個になるまで末尾に関数の実行結果を挿入する。
+// 要素数がn個以上の場合は、元のスライスと要素を共有するスライスを返す。
+// append と同じく、容量に余裕があれば元のスライスの後ろの領域に書き込む。
 func PadRightBy[T any](slice []T, n int, f func(int) T) []T {
 	if len(slice) >= n {
-		return slice
+		return Clip(slice)
 	}
 	c := n - len(slice)
 	t := make([]T, c)
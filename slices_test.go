@@ -0,0 +1,181 @@
+package slices
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRangeInclusiveFloat(t *testing.T) {
+	got, err := RangeInclusive(0.0, 0.3, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 4 || got[3] != 0.3 {
+		t.Errorf("RangeInclusive(0, 0.3, 0.1): got %v", got)
+	}
+
+	got, err = Range(0.0, 0.3, 0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Errorf("Range(0, 0.3, 0.1): got %v", got)
+	}
+}
+
+func TestRangeLargeFloat(t *testing.T) {
+	next, err := RangeIter(0.0, 1e19, 1.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if v, ok := next(); !ok || v != float64(i) {
+			t.Fatalf("RangeIter: got %v %v, want %v", v, ok, i)
+		}
+	}
+
+	if _, err := Range(0.0, math.Inf(1), 1); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Range: got %v", err)
+	}
+	if _, err := RangeInclusive(0.0, 1e19, 1); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("RangeInclusive: got %v", err)
+	}
+	if _, err := RangeIter(math.NaN(), 1, 1); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("RangeIter: got %v", err)
+	}
+}
+
+func TestRangeOverflow(t *testing.T) {
+	got, err := Range[uint8](250, 255, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(got, []uint8{250, 253}) {
+		t.Errorf("Range: got %v", got)
+	}
+
+	got, err = RangeInclusive[uint8](250, 255, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(got, []uint8{250, 255}) {
+		t.Errorf("RangeInclusive: got %v", got)
+	}
+}
+
+func TestInplaceKeepsOrder(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	if got := FilterInplace([]int{3, 1, 3, 2, 3}, 3); !Equal(got, []int{3, 3, 3}) {
+		t.Errorf("FilterInplace: got %v", got)
+	}
+	if got := FilterByInplace([]int{5, 4, 3, 2, 1, 0}, even); !Equal(got, []int{4, 2, 0}) {
+		t.Errorf("FilterByInplace: got %v", got)
+	}
+	if got := FilterNotInplace([]int{5, 3, 4, 3, 1}, 3); !Equal(got, []int{5, 4, 1}) {
+		t.Errorf("FilterNotInplace: got %v", got)
+	}
+	if got := FilterNotByInplace([]int{5, 4, 3, 2, 1, 0}, even); !Equal(got, []int{5, 3, 1}) {
+		t.Errorf("FilterNotByInplace: got %v", got)
+	}
+	if got := UniqueInplace([]int{3, 1, 3, 2, 1, 4}); !Equal(got, []int{3, 1, 2, 4}) {
+		t.Errorf("UniqueInplace: got %v", got)
+	}
+	eq := func(v1, v2 int) bool { return v1 == v2 }
+	if got := UniqueByInplace([]int{3, 1, 3, 2, 1, 4}, eq); !Equal(got, []int{3, 1, 2, 4}) {
+		t.Errorf("UniqueByInplace: got %v", got)
+	}
+
+	partitions := map[string]func([]int) ([]int, []int){
+		"PartitionInplace":         func(s []int) ([]int, []int) { return PartitionInplace(s, 2) },
+		"PartitionByInplace":       func(s []int) ([]int, []int) { return PartitionByInplace(s, func(v int) bool { return v == 2 }) },
+		"StablePartitionInplace":   func(s []int) ([]int, []int) { return StablePartitionInplace(s, 2) },
+		"StablePartitionByInplace": func(s []int) ([]int, []int) { return StablePartitionByInplace(s, func(v int) bool { return v == 2 }) },
+	}
+	for name, partition := range partitions {
+		a, b := partition([]int{9, 2, 8, 7, 2, 6, 5, 2, 4})
+		if !Equal(a, []int{2, 2, 2}) || !Equal(b, []int{9, 8, 7, 6, 5, 4}) {
+			t.Errorf("%s: got %v %v", name, a, b)
+		}
+	}
+}
+
+// 容量に余裕のある元のスライスを返す。
+func aliasingSource() []int {
+	slice := make([]int, 6, 12)
+	for i := range slice {
+		slice[i] = i + 1
+	}
+	return slice
+}
+
+func TestViewsDoNotAlias(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	small := func(v int) bool { return v < 3 }
+	pair := func(a, b []int) [][]int { return [][]int{a, b} }
+	one := func(a []int) [][]int { return [][]int{a} }
+
+	tests := map[string]func([]int) [][]int{
+		"Pop":                      func(s []int) [][]int { _, rest := Pop(s); return one(rest) },
+		"PopN":                     func(s []int) [][]int { return pair(PopN(s, 2)) },
+		"PopBack":                  func(s []int) [][]int { _, rest := PopBack(s); return one(rest) },
+		"PopBackN":                 func(s []int) [][]int { return pair(PopBackN(s, 2)) },
+		"Take":                     func(s []int) [][]int { return one(Take(s, 3)) },
+		"TakeWhile":                func(s []int) [][]int { return one(TakeWhile(s, 1)) },
+		"TakeWhileBy":              func(s []int) [][]int { return one(TakeWhileBy(s, small)) },
+		"Drop":                     func(s []int) [][]int { return one(Drop(s, 3)) },
+		"DropWhile":                func(s []int) [][]int { return one(DropWhile(s, 1)) },
+		"DropWhileBy":              func(s []int) [][]int { return one(DropWhileBy(s, small)) },
+		"Span":                     func(s []int) [][]int { return pair(Span(s, 1)) },
+		"SpanBy":                   func(s []int) [][]int { return pair(SpanBy(s, small)) },
+		"PartitionInplace":         func(s []int) [][]int { return pair(PartitionInplace(s, 2)) },
+		"PartitionByInplace":       func(s []int) [][]int { return pair(PartitionByInplace(s, even)) },
+		"StablePartitionInplace":   func(s []int) [][]int { return pair(StablePartitionInplace(s, 2)) },
+		"StablePartitionByInplace": func(s []int) [][]int { return pair(StablePartitionByInplace(s, even)) },
+		"Pad":                      func(s []int) [][]int { return one(Pad(s, 3, 0)) },
+		"PadZero":                  func(s []int) [][]int { return one(PadZero(s, 3)) },
+		"PadBy":                    func(s []int) [][]int { return one(PadBy(s, 3, func(int) int { return 0 })) },
+		"PadRight":                 func(s []int) [][]int { return one(PadRight(s, 3, 0)) },
+		"PadZeroRight":             func(s []int) [][]int { return one(PadZeroRight(s, 3)) },
+		"PadRightBy":               func(s []int) [][]int { return one(PadRightBy(s, 3, func(int) int { return 0 })) },
+	}
+
+	for name, f := range tests {
+		for i := range f(aliasingSource()) {
+			original := aliasingSource()
+			views := f(original)
+			want := Clone(original[:cap(original)])
+			others := Map(views, Clone[int])
+
+			_ = append(views[i], 99)
+
+			if !Equal(original[:cap(original)], want) {
+				t.Errorf("%s: appending to view %d changed the original: %v", name, i, original[:cap(original)])
+			}
+			for j := range views {
+				if j != i && !Equal(views[j], others[j]) {
+					t.Errorf("%s: appending to view %d changed view %d: %v", name, i, j, views[j])
+				}
+			}
+		}
+	}
+}
+
+func TestInsertDoesNotAlias(t *testing.T) {
+	original := aliasingSource()
+	v := make([]int, 1, 8)
+	got := Insert(original[:2], 1, v...)
+	if !Equal(original, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("Insert changed the original: %v", original)
+	}
+	if !Equal(got, []int{1, 0, 2}) {
+		t.Errorf("Insert: got %v", got)
+	}
+
+	got = PushBack(original, v...)
+	got[0] = 99
+	if v[:cap(v)][0] != 0 || v[:cap(v)][1] != 0 {
+		t.Errorf("PushBack wrote into v: %v", v[:cap(v)])
+	}
+}
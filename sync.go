@@ -0,0 +1,109 @@
+package slices
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// 値を持つ並行処理で安全なスライスを返す。
+func NewSyncSlice[T any](values ...T) *SyncSlice[T] {
+	return &SyncSlice[T]{slice: Clone(values)}
+}
+
+// 複数のゴルーチンから安全に操作できるスライス。
+// Snapshot の返すスライスは以降の変更の影響を受けない。
+type SyncSlice[T any] struct {
+	mu     sync.RWMutex
+	slice  []T
+	shared int32 // スナップショットと要素を共有していたら1。atomic で読み書きする。
+}
+
+// 末尾に要素を追加する。
+func (s *SyncSlice[T]) Append(v ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.own(len(v))
+	s.slice = append(s.slice, v...)
+}
+
+// 指定した位置に要素を追加する。
+// 位置が範囲外の場合は何も変更せずにpanicする。
+func (s *SyncSlice[T]) Insert(index int, v ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index < 0 || index > len(s.slice) {
+		panic("slices: index out of range")
+	}
+	s.own(len(v))
+	n := len(s.slice)
+	s.slice = append(s.slice, v...)
+	copy(s.slice[index+len(v):], s.slice[index:n])
+	copy(s.slice[index:], v)
+}
+
+// 指定した位置の要素を削除する。
+// 位置が範囲外の場合は何も変更せずにpanicする。
+func (s *SyncSlice[T]) Remove(index int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index < 0 || index >= len(s.slice) {
+		panic("slices: index out of range")
+	}
+	s.own(0)
+	s.slice = Remove(s.slice, index)
+}
+
+// 指定した位置の要素を返す。
+func (s *SyncSlice[T]) Get(index int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return Get(s.slice, index)
+}
+
+// 要素数を返す。
+func (s *SyncSlice[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.slice)
+}
+
+// 要素を先頭から順に関数に渡す。関数が false を返したら終了する。
+// 呼び出した時点のスナップショットを走査するため、関数の中でスライスを変更できる。
+func (s *SyncSlice[T]) Range(f func(int, T) bool) {
+	snapshot := s.Snapshot()
+	for i := range snapshot {
+		if !f(i, snapshot[i]) {
+			return
+		}
+	}
+}
+
+// 関数の返すスライスで置き換える。関数の実行中は他の操作を待たせる。
+// 関数に渡すスライスは関数の外に持ち出してはならない。
+func (s *SyncSlice[T]) Update(f func([]T) []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.own(0)
+	s.slice = f(s.slice)
+}
+
+// 現在の要素のスライスを返す。
+// 返すスライスは変更してはならない。以降の変更は返したスライスに影響しない。
+func (s *SyncSlice[T]) Snapshot() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	// 書き込みは排他ロックの中で shared を見るため、読み込みロックで十分。
+	atomic.StoreInt32(&s.shared, 1)
+	return Clip(s.slice)
+}
+
+// スナップショットと要素を共有していたらコピーする。
+func (s *SyncSlice[T]) own(n int) {
+	if atomic.LoadInt32(&s.shared) == 0 {
+		return
+	}
+	slice := make([]T, len(s.slice), len(s.slice)+n)
+	copy(slice, s.slice)
+	s.slice = slice
+	atomic.StoreInt32(&s.shared, 0)
+}
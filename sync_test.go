@@ -0,0 +1,83 @@
+package slices
+
+import (
+	"sync"
+	"testing"
+)
+
+// go test -race で実行する。
+func TestSyncSliceConcurrent(t *testing.T) {
+	s := NewSyncSlice(0, 1, 2)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s.Append(i)
+				s.Insert(0, j)
+				s.Remove(0)
+				s.Get(j)
+				s.Len()
+				s.Range(func(int, int) bool { return true })
+				s.Update(func(slice []int) []int { return slice })
+
+				snapshot := s.Snapshot()
+				want := Clone(snapshot)
+				s.Append(j)
+				if !Equal(snapshot, want) {
+					t.Errorf("snapshot changed: got %v, want %v", snapshot, want)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := s.Len(); got != 3+8*100*2 {
+		t.Errorf("Len: got %d", got)
+	}
+}
+
+func TestSyncSliceSnapshot(t *testing.T) {
+	s := NewSyncSlice(1, 2, 3)
+	snapshot := s.Snapshot()
+	s.Insert(1, 9)
+	s.Remove(0)
+	s.Update(func(slice []int) []int {
+		slice[0] = 7
+		return slice
+	})
+	if !Equal(snapshot, []int{1, 2, 3}) {
+		t.Errorf("snapshot: got %v", snapshot)
+	}
+	if got := s.Snapshot(); !Equal(got, []int{7, 2, 3}) {
+		t.Errorf("current: got %v", got)
+	}
+}
+
+func TestSyncSliceOutOfRange(t *testing.T) {
+	s := NewSyncSlice(1, 2)
+	snapshot := s.Snapshot()
+	for name, f := range map[string]func(){
+		"Insert":         func() { s.Insert(5, 9) },
+		"InsertNegative": func() { s.Insert(-1, 9) },
+		"Remove":         func() { s.Remove(2) },
+		"RemoveNegative": func() { s.Remove(-1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: did not panic", name)
+				}
+			}()
+			f()
+		}()
+		if got := s.Snapshot(); !Equal(got, []int{1, 2}) {
+			t.Errorf("%s: changed the slice: %v", name, got)
+		}
+	}
+	if !Equal(snapshot, []int{1, 2}) {
+		t.Errorf("snapshot: got %v", snapshot)
+	}
+}
@@ -12,6 +12,10 @@ type complex interface {
 	~complex64 | ~complex128
 }
 
+type number interface {
+	integer | float
+}
+
 type ordered interface {
 	integer | float | string
 }
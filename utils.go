@@ -1,6 +1,10 @@
 package slices
 
-import "github.com/thamaji/slices/tuple"
+import (
+	"reflect"
+
+	"github.com/thamaji/slices/tuple"
+)
 
 // 指定した値のポインタを返す。
 func GetPtr[T any](v T) *T {
@@ -15,6 +19,95 @@ func FromPtr[T any](v *T) []T {
 	return []T{*v}
 }
 
+// 各要素へのポインタのスライスを返す。
+// ポインタは元のスライスの要素を指す。
+func Ptrs[T any](slice []T) []*T {
+	dst := make([]*T, len(slice))
+	for i := range slice {
+		dst[i] = &slice[i]
+	}
+	return dst
+}
+
+// 各要素をコピーした値へのポインタのスライスを返す。
+func PtrsCopy[T any](slice []T) []*T {
+	values := Clone(slice)
+	dst := make([]*T, len(values))
+	for i := range values {
+		dst[i] = &values[i]
+	}
+	return dst
+}
+
+// ポインタの指す値のスライスを返す。nilは除く。
+func Derefs[T any](slice []*T) []T {
+	dst := make([]T, 0, len(slice))
+	for i := range slice {
+		if slice[i] != nil {
+			dst = append(dst, *slice[i])
+		}
+	}
+	return dst
+}
+
+// ポインタの指す値のスライスを返す。
+// nilが含まれる場合は ErrNilPointer を返す。
+func DerefsStrict[T any](slice []*T) ([]T, error) {
+	dst := make([]T, len(slice))
+	for i := range slice {
+		if slice[i] == nil {
+			return nil, ErrNilPointer
+		}
+		dst[i] = *slice[i]
+	}
+	return dst, nil
+}
+
+// ポインタの指す値のスライスを返す。nilはvに置き換える。
+func DerefsOr[T any](slice []*T, v T) []T {
+	dst := make([]T, len(slice))
+	for i := range slice {
+		if slice[i] == nil {
+			dst[i] = v
+		} else {
+			dst[i] = *slice[i]
+		}
+	}
+	return dst
+}
+
+// nilの要素を除いたスライスを返す。
+// ポインタ、インターフェース、マップ、スライス、関数、チャネルのnilを除く。
+func CleanNil[T any](slice []T) []T {
+	dst := make([]T, 0, len(slice))
+	for i := range slice {
+		if !isNil(slice[i]) {
+			dst = append(dst, slice[i])
+		}
+	}
+	return dst
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// 各要素へのポインタを受け取る関数を実行する。
+// 関数の中で要素を書き換えることができる。
+func MapPtr[T any](slice []T, f func(*T)) {
+	for i := range slice {
+		f(&slice[i])
+	}
+}
+
 // マップをスライスに変換する。
 func FromMap[K ordered, V any](m map[K]V) []tuple.T2[K, V] {
 	entries := make([]tuple.T2[K, V], 0, len(m))
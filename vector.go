@@ -0,0 +1,201 @@
+package slices
+
+import "math"
+
+// 同じ位置の要素を足したスライスを返す。
+func Add[T number](slice1 []T, slice2 []T) ([]T, error) {
+	if len(slice1) != len(slice2) {
+		return nil, ErrLengthMismatch
+	}
+	dst := make([]T, len(slice1))
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = slice1[i] + slice2[i]
+		dst[i+1] = slice1[i+1] + slice2[i+1]
+		dst[i+2] = slice1[i+2] + slice2[i+2]
+		dst[i+3] = slice1[i+3] + slice2[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = slice1[i] + slice2[i]
+	}
+	return dst, nil
+}
+
+// 同じ位置の要素を引いたスライスを返す。
+func Sub[T number](slice1 []T, slice2 []T) ([]T, error) {
+	if len(slice1) != len(slice2) {
+		return nil, ErrLengthMismatch
+	}
+	dst := make([]T, len(slice1))
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = slice1[i] - slice2[i]
+		dst[i+1] = slice1[i+1] - slice2[i+1]
+		dst[i+2] = slice1[i+2] - slice2[i+2]
+		dst[i+3] = slice1[i+3] - slice2[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = slice1[i] - slice2[i]
+	}
+	return dst, nil
+}
+
+// 同じ位置の要素を掛けたスライスを返す。
+func Mul[T number](slice1 []T, slice2 []T) ([]T, error) {
+	if len(slice1) != len(slice2) {
+		return nil, ErrLengthMismatch
+	}
+	dst := make([]T, len(slice1))
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = slice1[i] * slice2[i]
+		dst[i+1] = slice1[i+1] * slice2[i+1]
+		dst[i+2] = slice1[i+2] * slice2[i+2]
+		dst[i+3] = slice1[i+3] * slice2[i+3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = slice1[i] * slice2[i]
+	}
+	return dst, nil
+}
+
+// 同じ位置の要素を割ったスライスを返す。
+// 整数を0で割った場合はpanicする。
+func Div[T number](slice1 []T, slice2 []T) ([]T, error) {
+	if len(slice1) != len(slice2) {
+		return nil, ErrLengthMismatch
+	}
+	dst := make([]T, len(slice1))
+	for i := range dst {
+		dst[i] = slice1[i] / slice2[i]
+	}
+	return dst, nil
+}
+
+// すべての要素にkを掛けたスライスを返す。
+func Scale[T number](slice []T, k T) []T {
+	dst := make([]T, len(slice))
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		dst[i] = slice[i] * k
+		dst[i+1] = slice[i+1] * k
+		dst[i+2] = slice[i+2] * k
+		dst[i+3] = slice[i+3] * k
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = slice[i] * k
+	}
+	return dst
+}
+
+// 内積を返す。
+func Dot[T number](slice1 []T, slice2 []T) (T, error) {
+	if len(slice1) != len(slice2) {
+		return *new(T), ErrLengthMismatch
+	}
+	var v0, v1, v2, v3 T
+	i := 0
+	for ; i+4 <= len(slice1); i += 4 {
+		v0 += slice1[i] * slice2[i]
+		v1 += slice1[i+1] * slice2[i+1]
+		v2 += slice1[i+2] * slice2[i+2]
+		v3 += slice1[i+3] * slice2[i+3]
+	}
+	for ; i < len(slice1); i++ {
+		v0 += slice1[i] * slice2[i]
+	}
+	return v0 + v1 + v2 + v3, nil
+}
+
+// L1ノルムを返す。
+func NormL1[T number](slice []T) float64 {
+	v := 0.0
+	for i := range slice {
+		v += math.Abs(float64(slice[i]))
+	}
+	return v
+}
+
+// L2ノルムを返す。
+func NormL2[T number](slice []T) float64 {
+	v := 0.0
+	for i := range slice {
+		f := float64(slice[i])
+		v += f * f
+	}
+	return math.Sqrt(v)
+}
+
+// 最大値ノルムを返す。
+func NormInf[T number](slice []T) float64 {
+	v := 0.0
+	for i := range slice {
+		if f := math.Abs(float64(slice[i])); v < f {
+			v = f
+		}
+	}
+	return v
+}
+
+// 先頭からの累積和のスライスを返す。
+func CumSum[T number](slice []T) []T {
+	dst := make([]T, len(slice))
+	var v T
+	for i := range slice {
+		v += slice[i]
+		dst[i] = v
+	}
+	return dst
+}
+
+// 先頭からの累積積のスライスを返す。
+func CumProd[T number](slice []T) []T {
+	dst := make([]T, len(slice))
+	var v T = 1
+	for i := range slice {
+		v *= slice[i]
+		dst[i] = v
+	}
+	return dst
+}
+
+// 隣り合う要素の差のスライスを返す。
+func Diff[T number](slice []T) []T {
+	if len(slice) < 2 {
+		return []T{}
+	}
+	dst := make([]T, len(slice)-1)
+	for i := range dst {
+		dst[i] = slice[i+1] - slice[i]
+	}
+	return dst
+}
+
+// 要素をminからmaxの範囲に収めたスライスを返す。
+func Clamp[T ordered](slice []T, min T, max T) []T {
+	dst := make([]T, len(slice))
+	for i := range slice {
+		switch {
+		case slice[i] < min:
+			dst[i] = min
+		case slice[i] > max:
+			dst[i] = max
+		default:
+			dst[i] = slice[i]
+		}
+	}
+	return dst
+}
+
+// 要素を絶対値にしたスライスを返す。
+func Abs[T number](slice []T) []T {
+	dst := make([]T, len(slice))
+	for i := range slice {
+		if slice[i] < 0 {
+			dst[i] = -slice[i]
+		} else {
+			dst[i] = slice[i]
+		}
+	}
+	return dst
+}